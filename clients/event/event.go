@@ -0,0 +1,56 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"user-service/config"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DriverLog     = "log"
+	DriverWebhook = "webhook"
+)
+
+// Event adalah notifikasi perubahan data user untuk service lain
+type Event struct {
+	ID         uuid.UUID      `json:"id"`
+	Type       string         `json:"type"`
+	Subject    uuid.UUID      `json:"subject"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// NewEvent membuat event baru dengan ID dan waktu kejadian saat ini
+func NewEvent(eventType string, subject uuid.UUID, data map[string]any) *Event {
+	return &Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		Subject:    subject,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+// IPublisher mengirim event ke service lain yang bergantung pada data user
+type IPublisher interface {
+	Publish(context.Context, *Event) error
+}
+
+// NewPublisher membuat publisher sesuai config.Events.Driver
+func NewPublisher() (IPublisher, error) {
+	cfg := config.Config.Events
+	switch cfg.Driver {
+	case "", DriverLog:
+		return NewLogPublisher(), nil
+	case DriverWebhook:
+		if cfg.WebhookURL == "" {
+			return nil, fmt.Errorf("events webhook driver requires webhookURL")
+		}
+		return NewWebhookPublisher(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown events driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogPublisher hanya menulis event ke log, dipakai untuk pengembangan lokal
+type LogPublisher struct{}
+
+func NewLogPublisher() IPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, event *Event) error {
+	logrus.Infof("event %s %s for %s", event.ID, event.Type, event.Subject)
+	return nil
+}
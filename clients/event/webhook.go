@@ -0,0 +1,62 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"user-service/config"
+	"user-service/constants"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookPublisher mengirim event sebagai JSON lewat HTTP POST. Body ditandatangani
+// HMAC-SHA256 dengan signature key agar penerima bisa memverifikasi asal event.
+type WebhookPublisher struct {
+	cfg    config.Events
+	client *http.Client
+}
+
+func NewWebhookPublisher(cfg config.Events) IPublisher {
+	timeout := defaultWebhookTimeout
+	if cfg.TimeoutSecond > 0 {
+		timeout = time.Duration(cfg.TimeoutSecond) * time.Second
+	}
+	return &WebhookPublisher{cfg: cfg, client: &http.Client{Timeout: timeout}}
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(config.Config.SignatureKey))
+	mac.Write([]byte(timestamp + "." + string(body)))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(constants.XServiceName, config.Config.AppName)
+	request.Header.Set(constants.XRequestAt, timestamp)
+	request.Header.Set(constants.XSignature, hex.EncodeToString(mac.Sum(nil)))
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("event webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
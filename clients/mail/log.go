@@ -0,0 +1,19 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogMailer hanya menulis email ke log, dipakai untuk pengembangan lokal
+type LogMailer struct{}
+
+func NewLogMailer() IMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(_ context.Context, message *Message) error {
+	logrus.Infof("mail to %s: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
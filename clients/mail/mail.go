@@ -0,0 +1,36 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"user-service/config"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// IMailer mengirim email transaksional berupa teks biasa
+type IMailer interface {
+	Send(context.Context, *Message) error
+}
+
+// NewMailer membuat mailer sesuai config.Mail.Driver
+func NewMailer() (IMailer, error) {
+	cfg := config.Config.Mail
+	switch cfg.Driver {
+	case "", DriverLog:
+		return NewLogMailer(), nil
+	case DriverSMTP:
+		return NewSMTPMailer(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,47 @@
+package clients
+
+import (
+	eventClient "user-service/clients/event"
+	mailClient "user-service/clients/mail"
+	storageClient "user-service/clients/storage"
+)
+
+type Registry struct {
+	storage   storageClient.IStorage
+	mailer    mailClient.IMailer
+	publisher eventClient.IPublisher
+}
+
+type IClientRegistry interface {
+	GetStorage() storageClient.IStorage
+	GetMailer() mailClient.IMailer
+	GetPublisher() eventClient.IPublisher
+}
+
+func NewClientRegistry() (IClientRegistry, error) {
+	storage, err := storageClient.NewStorage()
+	if err != nil {
+		return nil, err
+	}
+	mailer, err := mailClient.NewMailer()
+	if err != nil {
+		return nil, err
+	}
+	publisher, err := eventClient.NewPublisher()
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{storage: storage, mailer: mailer, publisher: publisher}, nil
+}
+
+func (r *Registry) GetStorage() storageClient.IStorage {
+	return r.storage
+}
+
+func (r *Registry) GetMailer() mailClient.IMailer {
+	return r.mailer
+}
+
+func (r *Registry) GetPublisher() eventClient.IPublisher {
+	return r.publisher
+}
@@ -0,0 +1,50 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) IStorage {
+	return &LocalStorage{root: root}
+}
+
+// path memetakan key ke path di bawah root dan menolak key yang keluar dari root
+func (s *LocalStorage) path(key string) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	relative, err := filepath.Rel(s.root, path)
+	if err != nil || relative == "." || strings.HasPrefix(relative, "..") {
+		return "", errors.New("invalid storage key")
+	}
+	return path, nil
+}
+
+func (s *LocalStorage) Put(_ context.Context, key string, body []byte, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, body, 0o644)
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
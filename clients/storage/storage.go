@@ -0,0 +1,65 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"user-service/config"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+
+	defaultLocalPath = "storage"
+
+	// LocalRoute adalah path tempat service menyajikan file backend local
+	LocalRoute = "/storage"
+)
+
+// IStorage adalah object storage tempat file user (misalnya avatar) disimpan.
+// Key selalu memakai pemisah "/" apa pun backend-nya.
+type IStorage interface {
+	Put(ctx context.Context, key string, body []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStorage membuat backend storage sesuai config.Storage.Driver
+func NewStorage() (IStorage, error) {
+	cfg := config.Config.Storage
+	switch cfg.Driver {
+	case "", DriverLocal:
+		return NewLocalStorage(LocalPath()), nil
+	case DriverS3:
+		return NewS3Storage(cfg.S3), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// IsLocal menandakan file disajikan langsung oleh service ini
+func IsLocal() bool {
+	driver := config.Config.Storage.Driver
+	return driver == "" || driver == DriverLocal
+}
+
+// LocalPath adalah direktori root untuk backend local
+func LocalPath() string {
+	if config.Config.Storage.LocalPath == "" {
+		return defaultLocalPath
+	}
+	return config.Config.Storage.LocalPath
+}
+
+// URL mengembalikan URL publik untuk key, dibentuk dari config.Storage.PublicURL.
+// Backend local tanpa PublicURL memakai LocalRoute yang disajikan service ini.
+func URL(key string) string {
+	if key == "" {
+		return ""
+	}
+	publicURL := config.Config.Storage.PublicURL
+	if publicURL == "" && IsLocal() {
+		publicURL = LocalRoute
+	}
+	return strings.TrimRight(publicURL, "/") + "/" + key
+}
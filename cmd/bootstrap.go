@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"time"
+	// database zona waktu IANA ikut di-embed agar validasi zona waktu tidak bergantung pada OS
+	_ "time/tzdata"
+	"user-service/clients"
+	"user-service/config"
+	"user-service/constants"
+	"user-service/database/migrations"
+	"user-service/repositories"
+	"user-service/services"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+)
+
+// openDatabase menyiapkan config, zona waktu service, dan koneksi database
+func openDatabase() *gorm.DB {
+	_ = godotenv.Load()
+	config.Init()
+	db, err := config.InitDatabase()
+	if err != nil {
+		panic(err)
+	}
+
+	// zona waktu service hanya dipakai jika request tidak membawa preferensi zona waktu
+	timezone := config.Config.Timezone
+	if timezone == "" {
+		timezone = constants.DefaultTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		panic(err)
+	}
+	time.Local = loc
+	return db
+}
+
+// bootstrap menyiapkan config, database, dan registry yang dipakai setiap command.
+// Migrasi hanya dijalankan jika migrate bernilai true; selain itu skema diharapkan
+// sudah disiapkan lewat command migrate up. Data awal diisi lewat command seed.
+func bootstrap(migrate bool) (repositories.IRepositoryRegistry, services.IServiceRegistry) {
+	db := openDatabase()
+	if migrate {
+		if err := migrations.NewMigrationRegistry(db).Up(context.Background()); err != nil {
+			panic(err)
+		}
+	}
+
+	repository := repositories.NewRepositoryRegistry(db)
+	client, err := clients.NewClientRegistry()
+	if err != nil {
+		panic(err)
+	}
+	service := services.NewServiceRegistry(repository, client)
+	return repository, service
+}
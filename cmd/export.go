@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"user-service/constants"
+	"user-service/domain/dto"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var exportFlags struct {
+	format       string
+	output       string
+	mask         bool
+	search       string
+	role         string
+	status       string
+	organization string
+	attributes   map[string]string
+}
+
+var exportCommand = &cobra.Command{
+	Use:   "export",
+	Short: "Export users as CSV or JSON Lines",
+	RunE: func(c *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if exportFlags.organization != "" {
+			tenant, err := uuid.Parse(exportFlags.organization)
+			if err != nil {
+				return err
+			}
+			ctx = context.WithValue(ctx, constants.Tenant, tenant)
+		}
+
+		output := os.Stdout
+		if exportFlags.output != "" {
+			file, err := os.Create(exportFlags.output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			output = file
+		}
+
+		_, service := bootstrap(false)
+		return service.GetUser().Export(ctx, output, &dto.UserExportRequest{
+			UserListRequest: dto.UserListRequest{
+				Search:     exportFlags.search,
+				Role:       exportFlags.role,
+				Status:     exportFlags.status,
+				Attributes: exportFlags.attributes,
+			},
+			Format: exportFlags.format,
+			Mask:   exportFlags.mask,
+		})
+	},
+}
+
+func init() {
+	exportCommand.Flags().StringVar(&exportFlags.format, "format", constants.ExportFormatCSV, "csv or jsonl")
+	exportCommand.Flags().StringVarP(&exportFlags.output, "output", "o", "", "write to this file instead of stdout")
+	exportCommand.Flags().BoolVar(&exportFlags.mask, "mask", false, "mask name, email and phone number and leave out attributes")
+	exportCommand.Flags().StringVar(&exportFlags.search, "search", "", "filter by name, username, email or phone number")
+	exportCommand.Flags().StringVar(&exportFlags.role, "role", "", "filter by role code")
+	exportCommand.Flags().StringVar(&exportFlags.status, "status", "", "filter by status")
+	exportCommand.Flags().StringVar(&exportFlags.organization, "organization", "", "organization UUID to export from")
+	exportCommand.Flags().StringToStringVar(&exportFlags.attributes, "attribute", nil, "filter by attribute, e.g. --attribute gender=female")
+	command.AddCommand(exportCommand)
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"user-service/constants"
+	"user-service/domain/dto"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var importFlags struct {
+	file         string
+	format       string
+	dryRun       bool
+	batchSize    int
+	organization string
+	report       string
+}
+
+var importCommand = &cobra.Command{
+	Use:   "import",
+	Short: "Import users from a CSV or JSON file",
+	RunE: func(c *cobra.Command, args []string) error {
+		format := importFlags.format
+		if format == "" {
+			format = strings.TrimPrefix(strings.ToLower(filepath.Ext(importFlags.file)), ".")
+		}
+
+		ctx := context.Background()
+		if importFlags.organization != "" {
+			tenant, err := uuid.Parse(importFlags.organization)
+			if err != nil {
+				return err
+			}
+			ctx = context.WithValue(ctx, constants.Tenant, tenant)
+		}
+
+		file, err := os.Open(importFlags.file)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, service := bootstrap(false)
+		report, err := service.GetImport().Import(ctx, file, &dto.ImportRequest{
+			Format:    format,
+			DryRun:    importFlags.dryRun,
+			BatchSize: importFlags.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		output := os.Stdout
+		if importFlags.report != "" {
+			output, err = os.Create(importFlags.report)
+			if err != nil {
+				return err
+			}
+			defer output.Close()
+		}
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	},
+}
+
+func init() {
+	importCommand.Flags().StringVarP(&importFlags.file, "file", "f", "", "path to the CSV or JSON file")
+	importCommand.Flags().StringVar(&importFlags.format, "format", "", "csv or json (default: from the file extension)")
+	importCommand.Flags().BoolVar(&importFlags.dryRun, "dry-run", false, "validate only, do not create users")
+	importCommand.Flags().IntVar(&importFlags.batchSize, "batch-size", constants.ImportDefaultBatchSize, "rows per transaction")
+	importCommand.Flags().StringVar(&importFlags.organization, "organization", "", "organization UUID to import into")
+	importCommand.Flags().StringVar(&importFlags.report, "report", "", "write the report to this file instead of stdout")
+	_ = importCommand.MarkFlagRequired("file")
+	command.AddCommand(importCommand)
+}
@@ -1,60 +1,41 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
+	storageClient "user-service/clients/storage"
 	"user-service/common/response"
 	"user-service/config"
 	"user-service/constants"
 	"user-service/controllers"
-	"user-service/database/seeders"
-	"user-service/domain/models"
+	"user-service/jobs"
 	"user-service/middlewares"
-	"user-service/repositories"
 	"user-service/routes"
-	"user-service/services"
 
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
 )
 
+var serveFlags struct {
+	migrate bool
+}
+
 var command = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the Server",
 	Run: func(c *cobra.Command, args []string) {
-		_ = godotenv.Load()
-		config.Init()
-		db, err := config.InitDatabase()
-		if err != nil {
-			panic(err)
-		}
-
-		loc, err := time.LoadLocation("Asia/Jakarta")
-		if err != nil {
-			panic(err)
-		}
-		time.Local = loc
-
-		err = db.AutoMigrate(
-			&models.Role{},
-			&models.User{},
-		)
-
-		if err != nil {
-			panic(err)
-		}
-
-		seeders.NewSeederRegistry(db).Run()
-		repository := repositories.NewRepositoryRegistry(db)
-		service := services.NewServiceRegistry(repository)
+		repository, service := bootstrap(serveFlags.migrate)
+		middlewares.Init(repository)
 		controller := controllers.NewControllerRegistry(service)
+		jobs.NewJobRegistry(service).Start(context.Background())
 
 		router := gin.Default()
 		router.Use(middlewares.HandlePanic())
+		router.Use(middlewares.RequestInfo())
 		router.NoRoute(func(c *gin.Context) {
 			c.JSON(http.StatusNotFound, response.Response{
 				Status:  constants.Error,
@@ -69,8 +50,9 @@ var command = &cobra.Command{
 		})
 		router.Use(func(c *gin.Context) {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,DELETE,OPTIONS")
-			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization,x-service-name,x-api-key,x-request-at")
+			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
+			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type,Authorization,x-service-name,x-api-key,x-request-at,x-organization-id,x-request-id,if-match,x-timezone,accept-language")
+			c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag,X-Request-Id")
 			c.Next()
 		})
 
@@ -87,7 +69,12 @@ var command = &cobra.Command{
 		// Pasang middleware rate limiter
 		router.Use(middlewares.RateLimit(lmt))
 
+		if storageClient.IsLocal() {
+			router.Static(storageClient.LocalRoute, storageClient.LocalPath())
+		}
+
 		group := router.Group("/api/v1")
+		group.Use(middlewares.Tenant())
 		route := routes.NewRouteRegistry(controller, group)
 		route.Serve()
 
@@ -96,6 +83,10 @@ var command = &cobra.Command{
 	},
 }
 
+func init() {
+	command.Flags().BoolVar(&serveFlags.migrate, "migrate", false, "apply pending migrations before starting the server")
+}
+
 func Run() {
 	err := command.Execute()
 	if err != nil {
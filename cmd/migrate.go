@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+	"user-service/database/migrations"
+
+	"github.com/spf13/cobra"
+)
+
+var migrateFlags struct {
+	dir string
+}
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage versioned database migrations",
+}
+
+var migrateUpCommand = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		return migrations.NewMigrationRegistry(openDatabase()).Up(context.Background())
+	},
+}
+
+var migrateDownCommand = &cobra.Command{
+	Use:   "down N",
+	Short: "Roll back the last N applied migrations",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		steps, err := strconv.Atoi(args[0])
+		if err != nil || steps < 1 {
+			return fmt.Errorf("N must be a positive number")
+		}
+		return migrations.NewMigrationRegistry(openDatabase()).Down(context.Background(), steps)
+	},
+}
+
+var migrateStatusCommand = &cobra.Command{
+	Use:   "status",
+	Short: "Show applied and pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		statuses, err := migrations.NewMigrationRegistry(openDatabase()).Status(context.Background())
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tSTATUS")
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied at " + status.AppliedAt.Format(time.RFC3339)
+			}
+			if status.Missing {
+				state += " (missing in this build)"
+			}
+			fmt.Fprintf(writer, "%d\t%s\t%s\n", status.Version, status.Name, state)
+		}
+		return writer.Flush()
+	},
+}
+
+var migrateCreateCommand = &cobra.Command{
+	Use:   "create NAME",
+	Short: "Create an empty migration file",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		path, err := migrations.Create(migrateFlags.dir, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	},
+}
+
+func init() {
+	migrateCreateCommand.Flags().StringVar(&migrateFlags.dir, "dir", "database/migrations", "directory to write the migration file to")
+	migrateCommand.AddCommand(migrateUpCommand, migrateDownCommand, migrateStatusCommand, migrateCreateCommand)
+	command.AddCommand(migrateCommand)
+}
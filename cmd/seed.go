@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"user-service/config"
+	"user-service/constants"
+	"user-service/database/seeders"
+
+	"github.com/spf13/cobra"
+)
+
+var seedFlags struct {
+	env      string
+	fixtures string
+	list     bool
+}
+
+var seedCommand = &cobra.Command{
+	Use:   "seed [SEEDER...]",
+	Short: "Seed the database for an environment",
+	Long: "Run the named seeders, or every seeder in the environment profile when none is given.\n" +
+		"Seeding is idempotent: existing data is skipped.",
+	RunE: func(c *cobra.Command, args []string) error {
+		if seedFlags.list {
+			fmt.Printf("seeders: %s\n", strings.Join(seeders.Seeders, ", "))
+			for _, env := range []string{constants.EnvProduction, constants.EnvStaging, constants.EnvDevelopment} {
+				fmt.Printf("%s: %s\n", env, strings.Join(seeders.Profiles[env], ", "))
+			}
+			return nil
+		}
+
+		db := openDatabase()
+		env := seedFlags.env
+		if env == "" {
+			env = config.Config.Environment
+		}
+		if env == "" {
+			env = constants.DefaultEnvironment
+		}
+		path := seedFlags.fixtures
+		if path == "" {
+			path = config.Config.Seed.FixturesPath
+		}
+
+		fixtures, err := seeders.NewFixtures(path, env)
+		if err != nil {
+			return err
+		}
+		return seeders.NewSeederRegistry(db, fixtures).Run(context.Background(), args...)
+	},
+}
+
+func init() {
+	seedCommand.Flags().StringVar(&seedFlags.env, "env", "", "environment profile (default from config, then production)")
+	seedCommand.Flags().StringVar(&seedFlags.fixtures, "fixtures", "", "fixture directory (default from config, then the embedded fixtures)")
+	seedCommand.Flags().BoolVar(&seedFlags.list, "list", false, "list seeders and environment profiles")
+	command.AddCommand(seedCommand)
+}
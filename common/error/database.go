@@ -0,0 +1,59 @@
+package error
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// kode SQLSTATE Postgres untuk pelanggaran unique constraint
+	postgresUniqueViolation = "23505"
+	// ER_DUP_ENTRY pada MySQL
+	mysqlDuplicateEntry = 1062
+	// SQLITE_CONSTRAINT_UNIQUE dan SQLITE_CONSTRAINT_PRIMARYKEY pada SQLite
+	sqliteConstraintUnique     = 2067
+	sqliteConstraintPrimaryKey = 1555
+)
+
+// MySQL dan SQLite tidak menyediakan nama index sebagai field error, jadi diambil dari pesannya:
+// "Duplicate entry 'x' for key 'users.idx_users_tenant_email'" dan
+// "UNIQUE constraint failed: index 'idx_users_tenant_email'"
+var (
+	mysqlDuplicateKey  = regexp.MustCompile(`for key '([^']+)'`)
+	sqliteUniqueFailed = regexp.MustCompile(`UNIQUE constraint failed: (?:index '([^']+)'|([^\s(]+))`)
+)
+
+// UniqueViolation mengembalikan nama index atau constraint jika err adalah pelanggaran unique.
+// Untuk unique index pada kolom biasa SQLite hanya menyebut kolomnya, misalnya "users.uuid".
+func UniqueViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == postgresUniqueViolation {
+		return pgErr.ConstraintName, true
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+		constraint := ""
+		if match := mysqlDuplicateKey.FindStringSubmatch(mysqlErr.Message); match != nil {
+			// MySQL 8 menambahkan nama tabel di depan nama index
+			constraint = match[1][strings.LastIndex(match[1], ".")+1:]
+		}
+		return constraint, true
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) &&
+		(sqliteErr.Code() == sqliteConstraintUnique || sqliteErr.Code() == sqliteConstraintPrimaryKey) {
+		constraint := ""
+		if match := sqliteUniqueFailed.FindStringSubmatch(sqliteErr.Error()); match != nil {
+			constraint = match[1] + match[2]
+		}
+		return constraint, true
+	}
+	return "", false
+}
@@ -0,0 +1,100 @@
+package error
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestUniqueViolationPostgres(t *testing.T) {
+	err := fmt.Errorf("create user: %w", &pgconn.PgError{Code: "23505", ConstraintName: "idx_users_tenant_email"})
+	constraint, ok := UniqueViolation(err)
+	if !ok || constraint != "idx_users_tenant_email" {
+		t.Errorf("UniqueViolation = %q, %v", constraint, ok)
+	}
+
+	// pelanggaran constraint lain bukan unique violation
+	if _, ok := UniqueViolation(&pgconn.PgError{Code: "23503", ConstraintName: "fk_users_organization"}); ok {
+		t.Error("foreign key violation reported as unique violation")
+	}
+}
+
+func TestUniqueViolationMySQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{"MySQL 8 prefixes the table", "Duplicate entry '0-alice' for key 'users.idx_users_tenant_username'", "idx_users_tenant_username"},
+		{"MySQL 5.7 and MariaDB", "Duplicate entry '0-alice' for key 'idx_users_tenant_username'", "idx_users_tenant_username"},
+		{"unknown message format", "Duplicate entry", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			constraint, ok := UniqueViolation(&mysql.MySQLError{Number: 1062, Message: test.message})
+			if !ok || constraint != test.want {
+				t.Errorf("UniqueViolation = %q, %v, want %q", constraint, ok, test.want)
+			}
+		})
+	}
+
+	if _, ok := UniqueViolation(&mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row"}); ok {
+		t.Error("foreign key violation reported as unique violation")
+	}
+}
+
+// pesan SQLite diambil dari pelanggaran sungguhan karena formatnya berbeda antara index
+// ekspresi, index kolom, dan primary key
+func TestUniqueViolationSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range []string{
+		"CREATE TABLE users (id INTEGER PRIMARY KEY, uuid TEXT NOT NULL, email TEXT NOT NULL)",
+		"CREATE UNIQUE INDEX idx_users_uuid ON users (uuid)",
+		"CREATE UNIQUE INDEX idx_users_tenant_email ON users (lower(email))",
+		"INSERT INTO users (id, uuid, email) VALUES (1, 'a', 'alice@example.com')",
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		insert string
+		want   string
+	}{
+		{"expression index", "INSERT INTO users (id, uuid, email) VALUES (2, 'b', 'ALICE@example.com')", "idx_users_tenant_email"},
+		{"column index", "INSERT INTO users (id, uuid, email) VALUES (2, 'a', 'bob@example.com')", "users.uuid"},
+		{"primary key", "INSERT INTO users (id, uuid, email) VALUES (1, 'c', 'carol@example.com')", "users.id"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := db.Exec(test.insert)
+			if err == nil {
+				t.Fatal("insert succeeded")
+			}
+			constraint, ok := UniqueViolation(err)
+			if !ok || constraint != test.want {
+				t.Errorf("UniqueViolation(%v) = %q, %v, want %q", err, constraint, ok, test.want)
+			}
+		})
+	}
+
+	_, err = db.Exec("INSERT INTO users (id, uuid) VALUES (3, 'd')")
+	if _, ok := UniqueViolation(err); err == nil || ok {
+		t.Errorf("NOT NULL violation %v reported as unique violation", err)
+	}
+	if _, ok := UniqueViolation(errors.New("UNIQUE constraint failed: users.uuid")); ok {
+		t.Error("plain error reported as unique violation")
+	}
+}
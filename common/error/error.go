@@ -12,9 +12,39 @@ import (
 type ValidationResponse struct {
 	Field   string `json:"field,omitempty"`
 	Message string `json:"message,omitempty"`
+	// format dan args disimpan agar pesan bisa diterjemahkan sesuai locale request
+	format string
+	args   []any
 }
 
-var ErrValidation = map[string]string{}
+// NewValidationResponse membuat kesalahan validasi untuk field dengan pesan dari format
+func NewValidationResponse(field, format string, args ...any) ValidationResponse {
+	return ValidationResponse{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+		format:  format,
+		args:    args,
+	}
+}
+
+// Localize menyusun ulang pesan validasi memakai format hasil translate.
+// Pesan yang tidak dibuat lewat NewValidationResponse dibiarkan apa adanya.
+func Localize(items []ValidationResponse, translate func(string) string) []ValidationResponse {
+	localized := make([]ValidationResponse, 0, len(items))
+	for _, item := range items {
+		if item.format != "" {
+			item.Message = fmt.Sprintf(translate(item.format), item.args...)
+		}
+		localized = append(localized, item)
+	}
+	return localized
+}
+
+// ErrValidation berisi format pesan per tag validator; format dengan dua %s menerima parameter tag
+var ErrValidation = map[string]string{
+	"oneof":    "%s must be one of %s",
+	"timezone": "%s must be a valid IANA time zone",
+}
 
 func ErrValidationResponse(err error) (validationResponse []ValidationResponse) {
 	var fieldErrors validator.ValidationErrors
@@ -22,35 +52,25 @@ func ErrValidationResponse(err error) (validationResponse []ValidationResponse)
 		for _, err := range fieldErrors {
 			switch err.Tag() {
 			case "required":
-				validationResponse = append(validationResponse, ValidationResponse{
-					Field:   err.Field(),
-					Message: fmt.Sprintf("%s is required", err.Field()),
-				})
+				validationResponse = append(validationResponse,
+					NewValidationResponse(err.Field(), "%s is required", err.Field()))
 			case "email":
-				validationResponse = append(validationResponse, ValidationResponse{
-					Field:   err.Field(),
-					Message: fmt.Sprintf("%s must be a valid email", err.Field()),
-				})
+				validationResponse = append(validationResponse,
+					NewValidationResponse(err.Field(), "%s must be a valid email", err.Field()))
 			default:
 				errValidator, ok := ErrValidation[err.Tag()]
 				if ok {
 					count := strings.Count(errValidator, "%s")
 					if count == 1 {
-						validationResponse = append(validationResponse, ValidationResponse{
-							Field:   err.Field(),
-							Message: fmt.Sprintf(errValidator, err.Field()),
-						})
+						validationResponse = append(validationResponse,
+							NewValidationResponse(err.Field(), errValidator, err.Field()))
 					} else {
-						validationResponse = append(validationResponse, ValidationResponse{
-							Field:   err.Field(),
-							Message: fmt.Sprintf(errValidator, err.Field(), err.Param()),
-						})
+						validationResponse = append(validationResponse,
+							NewValidationResponse(err.Field(), errValidator, err.Field(), err.Param()))
 					}
 				} else {
-					validationResponse = append(validationResponse, ValidationResponse{
-						Field:   err.Field(),
-						Message: fmt.Sprintf("something wrong on %s; %s", err.Field(), err.Tag()),
-					})
+					validationResponse = append(validationResponse,
+						NewValidationResponse(err.Field(), "something wrong on %s; %s", err.Field(), err.Tag()))
 				}
 			}
 		}
@@ -62,3 +82,18 @@ func WrapError(err error) error {
 	logrus.Errorf("error: %v", err)
 	return err
 }
+
+// FieldError membawa detail validasi per field dari service ke controller.
+// Pesannya sama dengan Err sehingga tetap dikenali oleh ErrMapping.
+type FieldError struct {
+	Err    error
+	Fields []ValidationResponse
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
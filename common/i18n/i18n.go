@@ -0,0 +1,108 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/config"
+	"user-service/constants"
+)
+
+// catalog berisi terjemahan per locale dengan pesan bahasa Inggris sebagai kunci.
+// Pesan yang belum diterjemahkan tampil dalam bahasa Inggris.
+var catalog = map[string]map[string]string{
+	constants.LocaleIndonesian: indonesian,
+}
+
+// Supported memeriksa apakah locale termasuk bahasa yang didukung
+func Supported(locale string) bool {
+	return slices.Contains(constants.Locales, locale)
+}
+
+// DefaultLocale adalah locale service dari config, atau bahasa Inggris jika tidak diatur
+func DefaultLocale() string {
+	if Supported(config.Config.Locale) {
+		return config.Config.Locale
+	}
+	return constants.DefaultLocale
+}
+
+// Locale mengembalikan locale request dari context, atau locale default service
+func Locale(ctx context.Context) string {
+	if locale, ok := ctx.Value(constants.Locale).(string); ok {
+		return locale
+	}
+	return DefaultLocale()
+}
+
+// Location mengembalikan zona waktu request dari context, atau zona waktu service
+func Location(ctx context.Context) *time.Location {
+	if location, ok := ctx.Value(constants.Location).(*time.Location); ok {
+		return location
+	}
+	return time.Local
+}
+
+// WithPreferences menyimpan locale dan zona waktu ke context. Nilai kosong atau tidak
+// dikenal diabaikan sehingga preferensi sebelumnya tetap berlaku.
+func WithPreferences(ctx context.Context, locale, timezone string) context.Context {
+	if Supported(locale) {
+		ctx = context.WithValue(ctx, constants.Locale, locale)
+	}
+	if timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			ctx = context.WithValue(ctx, constants.Location, location)
+		}
+	}
+	return ctx
+}
+
+// ParseAcceptLanguage memilih locale yang didukung dengan bobot tertinggi dari header
+// Accept-Language, misalnya "id-ID,id;q=0.9,en;q=0.8". Mengembalikan string kosong
+// jika tidak ada yang didukung.
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		locale string
+		weight float64
+	}
+	candidates := []candidate{}
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		base, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if weight > 0 && Supported(base) {
+			candidates = append(candidates, candidate{locale: base, weight: weight})
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight > candidates[j].weight
+	})
+	return candidates[0].locale
+}
+
+// T menerjemahkan pesan ke locale; pesan tanpa terjemahan dikembalikan apa adanya
+func T(locale, message string) string {
+	if translated, ok := catalog[locale][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// Sprintf menerjemahkan format lalu mengisinya dengan args
+func Sprintf(locale, format string, args ...any) string {
+	return fmt.Sprintf(T(locale, format), args...)
+}
@@ -0,0 +1,103 @@
+package i18n
+
+var indonesian = map[string]string{
+	// pesan umum
+	"Unprocessable Entity":                      "Data tidak valid",
+	"Too many requests, please try again later": "Terlalu banyak permintaan, silakan coba lagi nanti",
+
+	// error
+	"internal server error":                               "terjadi kesalahan pada server",
+	"database server failed to execute query":             "server database gagal menjalankan query",
+	"too many requests":                                   "terlalu banyak permintaan",
+	"unauthorized":                                        "tidak terautentikasi",
+	"invalid token":                                       "token tidak valid",
+	"token has been revoked":                              "token sudah dicabut",
+	"forbidden":                                           "akses ditolak",
+	"invalid scope":                                       "scope tidak valid",
+	"insufficient scope":                                  "scope tidak mencukupi",
+	"invalid cursor":                                      "cursor tidak valid",
+	"request body must be a JSON object":                  "body request harus berupa objek JSON",
+	"unsupported content type":                            "content type tidak didukung",
+	"user not found":                                      "user tidak ditemukan",
+	"password incorrect":                                  "password salah",
+	"username exist":                                      "username sudah dipakai",
+	"email exist":                                         "email sudah dipakai",
+	"password does not match":                             "password tidak cocok",
+	"user suspended":                                      "user sedang disuspend",
+	"user deactivated":                                    "user sudah dinonaktifkan",
+	"user deleted":                                        "user sudah dihapus",
+	"invalid user status change":                          "perubahan status user tidak valid",
+	"user has been modified, version does not match":      "user sudah diubah, versi tidak cocok",
+	"organization not found":                              "organisasi tidak ditemukan",
+	"organization code exist":                             "kode organisasi sudah dipakai",
+	"invalid organization":                                "organisasi tidak valid",
+	"organization member not found":                       "anggota organisasi tidak ditemukan",
+	"role not found":                                      "role tidak ditemukan",
+	"group not found":                                     "grup tidak ditemukan",
+	"group member exist":                                  "anggota grup sudah ada",
+	"group member not found":                              "anggota grup tidak ditemukan",
+	"role elevation not found":                            "elevasi role tidak ditemukan",
+	"role elevation is not pending":                       "elevasi role tidak sedang menunggu",
+	"role elevation cannot be reviewed by the requester":  "elevasi role tidak boleh ditinjau oleh pemohonnya",
+	"role elevation duration exceeds the allowed maximum": "durasi elevasi role melebihi batas maksimum",
+	"role already assigned":                               "role sudah dimiliki",
+	"access review not found":                             "access review tidak ditemukan",
+	"access review already closed":                        "access review sudah ditutup",
+	"access review is not closed yet":                     "access review belum ditutup",
+	"access review still has undecided items":             "access review masih memiliki item yang belum diputuskan",
+	"access review item not found":                        "item access review tidak ditemukan",
+	"invalid export format":                               "format ekspor tidak valid",
+	"erasure request not found":                           "permintaan penghapusan tidak ditemukan",
+	"erasure request already pending":                     "permintaan penghapusan sudah ada",
+	"avatar file is required":                             "file avatar wajib diisi",
+	"avatar file is too large":                            "file avatar terlalu besar",
+	"avatar must be a jpeg, png or gif image":             "avatar harus berupa gambar jpeg, png, atau gif",
+	"avatar not found":                                    "avatar tidak ditemukan",
+	"attribute not found":                                 "atribut tidak ditemukan",
+	"attribute key already exist":                         "key atribut sudah dipakai",
+	"invalid attributes":                                  "atribut tidak valid",
+	"attribute key must start with a letter and contain only letters, digits or underscores": "key atribut harus diawali huruf dan hanya berisi huruf, angka, atau garis bawah",
+	"invalid attribute pattern":                               "pola atribut tidak valid",
+	"enum attribute requires options":                         "atribut enum wajib memiliki pilihan",
+	"import format must be csv or json":                       "format impor harus csv atau json",
+	"import file has an invalid header":                       "header file impor tidak valid",
+	"import file could not be parsed":                         "file impor tidak dapat dibaca",
+	"export format must be csv or jsonl":                      "format ekspor harus csv atau jsonl",
+	"email change request not found":                          "permintaan penggantian email tidak ditemukan",
+	"email change token is invalid or expired":                "token penggantian email tidak valid atau sudah kedaluwarsa",
+	"email can only be changed through the email change flow": "email hanya bisa diganti lewat alur penggantian email",
+	"new email is the same as the current email":              "email baru sama dengan email saat ini",
+	"audit log cannot be changed":                             "audit log tidak dapat diubah",
+	"invitation not found":                                    "undangan tidak ditemukan",
+	"invitation token is invalid or expired":                  "token undangan tidak valid atau sudah kedaluwarsa",
+	"a pending invitation already exists for this email":      "undangan untuk email ini masih menunggu",
+	"invitation is no longer pending":                         "undangan tidak lagi menunggu",
+
+	// validasi
+	"%s is required":                    "%s wajib diisi",
+	"%s must be a valid email":          "%s harus berupa email yang valid",
+	"something wrong on %s; %s":         "%s tidak valid; %s",
+	"%s is not a patchable field":       "%s tidak dapat diubah lewat patch",
+	"%s cannot be null":                 "%s tidak boleh null",
+	"%s is not a defined attribute":     "%s bukan atribut yang terdefinisi",
+	"%s must be a string":               "%s harus berupa teks",
+	"%s must be at least %v characters": "%s minimal %v karakter",
+	"%s must be at most %v characters":  "%s maksimal %v karakter",
+	"%s has an invalid format":          "format %s tidak valid",
+	"%s must be a number":               "%s harus berupa angka",
+	"%s must be at least %v":            "%s minimal %v",
+	"%s must be at most %v":             "%s maksimal %v",
+	"%s must be a boolean":              "%s harus berupa boolean",
+	"%s must be a date (YYYY-MM-DD)":    "%s harus berupa tanggal (YYYY-MM-DD)",
+	"%s must be one of %v":              "%s harus salah satu dari %v",
+	"%s must be a valid IANA time zone": "%s harus berupa zona waktu IANA yang valid",
+	"%s must be one of %s":              "%s harus salah satu dari %s",
+
+	// notifikasi
+	"Confirm your new email address": "Konfirmasi alamat email baru Anda",
+	"Hi %s,\n\nConfirm that %s is your new email address by opening the link below before %s:\n\n%s\n\nIf you did not request this change, ignore this email.": "Halo %s,\n\nKonfirmasi bahwa %s adalah alamat email baru Anda dengan membuka tautan di bawah sebelum %s:\n\n%s\n\nJika Anda tidak meminta perubahan ini, abaikan email ini.",
+	"Your email address is being changed": "Alamat email Anda sedang diganti",
+	"Hi %s,\n\nA request was made to change the email address of your account to %s. The change only takes effect once the new address is confirmed.\n\nIf this was not you, sign in and cancel the pending change, then change your password.": "Halo %s,\n\nAda permintaan untuk mengganti alamat email akun Anda menjadi %s. Perubahan baru berlaku setelah alamat baru dikonfirmasi.\n\nJika ini bukan Anda, masuk lalu batalkan perubahan tersebut, kemudian ganti password Anda.",
+	"You have been invited to create an account": "Anda diundang untuk membuat akun",
+	"Hi %s,\n\nYou have been invited to create an account as %s. Open the link below before %s to choose your username and password:\n\n%s\n\nIf you were not expecting this invitation, ignore this email.": "Halo %s,\n\nAnda diundang untuk membuat akun sebagai %s. Buka tautan di bawah sebelum %s untuk memilih username dan password Anda:\n\n%s\n\nJika Anda tidak mengharapkan undangan ini, abaikan email ini.",
+}
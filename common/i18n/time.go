@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// InLocation menyalin value dengan seluruh time.Time di dalamnya dipindahkan ke zona waktu
+// location, sehingga JSON response menampilkan waktu sesuai zona waktu pemanggil.
+// Value aslinya tidak diubah.
+func InLocation(value any, location *time.Location) any {
+	if value == nil || location == nil {
+		return value
+	}
+	return inLocation(reflect.ValueOf(value), location).Interface()
+}
+
+func inLocation(value reflect.Value, location *time.Location) reflect.Value {
+	if value.Type() == timeType {
+		return reflect.ValueOf(value.Interface().(time.Time).In(location))
+	}
+	if !containsTime(value.Type(), map[reflect.Type]bool{}) {
+		return value
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(inLocation(value.Elem(), location))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(inLocation(value.Elem(), location))
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := range value.NumField() {
+			if field := copied.Field(i); field.CanSet() {
+				field.Set(inLocation(value.Field(i), location))
+			}
+		}
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			copied.Index(i).Set(inLocation(value.Index(i), location))
+		}
+		return copied
+	case reflect.Array:
+		copied := reflect.New(value.Type()).Elem()
+		for i := range value.Len() {
+			copied.Index(i).Set(inLocation(value.Index(i), location))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), inLocation(iter.Value(), location))
+		}
+		return copied
+	}
+	return value
+}
+
+// containsTime memeriksa apakah tipe mungkin berisi time.Time, agar tipe sederhana
+// seperti string atau UUID tidak perlu disalin
+func containsTime(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if typ == timeType {
+		return true
+	}
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return containsTime(typ.Elem(), seen)
+	case reflect.Map:
+		return containsTime(typ.Elem(), seen)
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			if typ.Field(i).IsExported() && containsTime(typ.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
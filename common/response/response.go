@@ -1,7 +1,10 @@
 package response
 
 import (
+	"errors"
 	"net/http"
+	errWrap "user-service/common/error"
+	"user-service/common/i18n"
 	"user-service/constants"
 	errConstant "user-service/constants/error"
 
@@ -12,28 +15,53 @@ type Response struct {
 	Status  string      `json:"status"`
 	Message any         `json:"message"`
 	Data    interface{} `json:"data"`
+	Meta    *Pagination `json:"meta,omitempty"`
 	Token   *string     `json:"token,omitempty"`
 }
 
+// Pagination adalah blok meta standar untuk response berupa daftar.
+// Total dan TotalPages hanya terisi pada pagination berbasis halaman.
+type Pagination struct {
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	Total      int64  `json:"total,omitempty"`
+	TotalPages int    `json:"totalPages,omitempty"`
+	HasNext    bool   `json:"hasNext"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
 type ParamHTTPResponse struct {
 	Code    int
 	Err     error
 	Message *string
 	Gin     *gin.Context
 	Data    interface{}
+	Meta    *Pagination
 	Token   *string
 }
 
+// HTTPResponse menulis response standar. Pesan diterjemahkan sesuai locale request dan
+// waktu pada data ditampilkan dalam zona waktu pemanggil.
 func HTTPResponse(param ParamHTTPResponse) {
+	ctx := param.Gin.Request.Context()
+	locale := i18n.Locale(ctx)
 	if param.Err == nil {
 		param.Gin.JSON(param.Code, Response{
 			Status:  constants.Success,
 			Message: http.StatusText(http.StatusOK),
-			Data:    param.Data,
+			Data:    i18n.InLocation(param.Data, i18n.Location(ctx)),
+			Meta:    param.Meta,
 			Token:   param.Token,
 		})
 		return
 	}
+	// detail validasi dari service selalu dikirim sebagai 422
+	var fieldErr *errWrap.FieldError
+	if errors.As(param.Err, &fieldErr) {
+		param.Code = http.StatusUnprocessableEntity
+		param.Data = fieldErr.Fields
+	}
+
 	message := errConstant.ErrInternalServerError.Error()
 
 	if param.Message != nil {
@@ -44,9 +72,16 @@ func HTTPResponse(param ParamHTTPResponse) {
 		}
 	}
 
+	data := param.Data
+	if fields, ok := data.([]errWrap.ValidationResponse); ok {
+		data = errWrap.Localize(fields, func(format string) string {
+			return i18n.T(locale, format)
+		})
+	}
+
 	param.Gin.JSON(param.Code, Response{
 		Status:  constants.Error,
-		Message: message,
-		Data:    param.Data,
+		Message: i18n.T(locale, message),
+		Data:    data,
 	})
 }
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+)
+
+// Thumbnail memotong bagian tengah gambar menjadi persegi lalu mengubah ukurannya
+// menjadi size x size dengan rata-rata area. Piksel transparan diratakan ke latar putih
+// supaya hasilnya aman di-encode ke JPEG.
+func Thumbnail(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	offsetX := bounds.Min.X + (bounds.Dx()-side)/2
+	offsetY := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0 := offsetY + y*side/size
+		y1 := max(y0+1, offsetY+(y+1)*side/size)
+		for x := 0; x < size; x++ {
+			x0 := offsetX + x*side/size
+			x1 := max(x0+1, offsetX+(x+1)*side/size)
+
+			var r, g, b, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					background := uint64(0xffff - pa)
+					r += uint64(pr) + background
+					g += uint64(pg) + background
+					b += uint64(pb) + background
+					count++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / count >> 8),
+				G: uint8(g / count >> 8),
+				B: uint8(b / count >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"strings"
+)
+
+// MaskString menyisakan karakter pertama dan mengganti sisanya dengan '*'
+func MaskString(value string) string {
+	runes := []rune(value)
+	if len(runes) <= 1 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[0]) + strings.Repeat("*", len(runes)-1)
+}
+
+// MaskEmail menyamarkan bagian lokal email dan mempertahankan domainnya
+func MaskEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return MaskString(email)
+	}
+	return MaskString(local) + "@" + domain
+}
+
+// MaskPhone menyisakan empat digit terakhir nomor telepon
+func MaskPhone(phone string) string {
+	runes := []rune(phone)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+}
@@ -0,0 +1,50 @@
+package utils
+
+import "strings"
+
+// urutan pengecekan penting karena user agent browser saling menyebut nama browser lain
+var (
+	browsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"okhttp", "Android app"},
+		{"Dart/", "Mobile app"},
+		{"curl/", "curl"},
+	}
+	systems = []struct{ token, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceSummary meringkas user agent menjadi "<browser> on <sistem operasi>"
+func DeviceSummary(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+	browser, system := "Unknown browser", ""
+	for _, item := range browsers {
+		if strings.Contains(userAgent, item.token) {
+			browser = item.name
+			break
+		}
+	}
+	for _, item := range systems {
+		if strings.Contains(userAgent, item.token) {
+			system = item.name
+			break
+		}
+	}
+	if system == "" {
+		return browser
+	}
+	return browser + " on " + system
+}
@@ -10,29 +10,106 @@ import (
 var Config AppConfig
 
 type AppConfig struct {
-	Port                  int      `json:"port"`
-	AppName               string   `json:"appName"`
-	AppKey                string   `json:"appKey"`
-	SignatureKey          string   `json:"signatureKey"`
-	Database              Database `json:"database"`
-	RateLimiterMaxRequest float64  `json:"rateLimiterMaxRequest"`
-	RateLimiterTimeSecond int      `json:"rateLimiterTimeSecond"`
-	JwtSecretKey          string   `json:"jwtSecret"`
-	JwtExpireTime         int      `json:"jwtExpireTime"`
+	Port                     int      `json:"port"`
+	AppName                  string   `json:"appName"`
+	AppKey                   string   `json:"appKey"`
+	SignatureKey             string   `json:"signatureKey"`
+	Database                 Database `json:"database"`
+	RateLimiterMaxRequest    float64  `json:"rateLimiterMaxRequest"`
+	RateLimiterTimeSecond    int      `json:"rateLimiterTimeSecond"`
+	JwtSecretKey             string   `json:"jwtSecret"`
+	JwtExpireTime            int      `json:"jwtExpireTime"`
+	JwtIncludeGroups         bool     `json:"jwtIncludeGroups"`
+	SupportGroups            []string `json:"supportGroups"`
+	ElevationMaxMinutes      int      `json:"elevationMaxMinutes"`
+	ElevationSweepSecond     int      `json:"elevationSweepSecond"`
+	SuspensionSweepSecond    int      `json:"suspensionSweepSecond"`
+	ErasureGraceDays         int      `json:"erasureGraceDays"`
+	ErasureSweepSecond       int      `json:"erasureSweepSecond"`
+	AvatarMaxSizeKB          int64    `json:"avatarMaxSizeKB"`
+	Storage                  Storage  `json:"storage"`
+	Mail                     Mail     `json:"mail"`
+	FrontendURL              string   `json:"frontendURL"`
+	EmailChangeExpireMinutes int      `json:"emailChangeExpireMinutes"`
+	InvitationExpireMinutes  int      `json:"invitationExpireMinutes"`
+	Events                   Events   `json:"events"`
+	OutboxSweepSecond        int      `json:"outboxSweepSecond"`
+	LoginHistoryDays         int      `json:"loginHistoryDays"`
+	LoginHistorySweepSecond  int      `json:"loginHistorySweepSecond"`
+	Locale                   string   `json:"locale"`
+	Timezone                 string   `json:"timezone"`
+	UniquePreCheck           bool     `json:"uniquePreCheck"`
+	Environment              string   `json:"environment"`
+	Seed                     Seed     `json:"seed"`
 }
 
+// Database mengatur koneksi database. Driver berisi postgres (default), mysql, atau sqlite;
+// untuk sqlite, name adalah path file atau ":memory:". SSL mode mengikuti nilai sslmode
+// Postgres (disable, allow, prefer, require, verify-ca, verify-full) dan juga berlaku
+// untuk MySQL; sslPassword hanya untuk Postgres.
 type Database struct {
+	Driver                string `json:"driver"`
 	Host                  string `json:"host"`
 	Port                  int    `json:"port"`
 	Name                  string `json:"name"`
 	UserName              string `json:"username"`
 	Password              string `json:"password"`
+	SSLMode               string `json:"sslMode"`
+	SSLRootCert           string `json:"sslRootCert"`
+	SSLCert               string `json:"sslCert"`
+	SSLKey                string `json:"sslKey"`
+	SSLPassword           string `json:"sslPassword"`
 	MaxOpenConnections    int    `json:"maxOpenConnections"`
 	MaxLifeTimeConnection int    `json:"maxLifeTimeConnection"`
 	MaxIdleConnections    int    `json:"maxIdleConnections"`
 	MaxIdleTime           int    `json:"maxIdleTime"`
 }
 
+type Storage struct {
+	Driver    string `json:"driver"`
+	LocalPath string `json:"localPath"`
+	PublicURL string `json:"publicURL"`
+	S3        S3     `json:"s3"`
+}
+
+type S3 struct {
+	Endpoint  string `json:"endpoint"`
+	Region    string `json:"region"`
+	Bucket    string `json:"bucket"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	PathStyle bool   `json:"pathStyle"`
+}
+
+type Mail struct {
+	Driver   string `json:"driver"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+type Events struct {
+	Driver        string `json:"driver"`
+	WebhookURL    string `json:"webhookURL"`
+	TimeoutSecond int    `json:"timeoutSecond"`
+}
+
+type Seed struct {
+	FixturesPath string    `json:"fixturesPath"`
+	Admin        SeedAdmin `json:"admin"`
+}
+
+// SeedAdmin adalah akun admin awal; password kosong berarti dibuat acak dan ditampilkan sekali
+type SeedAdmin struct {
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phoneNumber"`
+	Password    string `json:"password"`
+}
+
 func Init() {
 	err := utils.BindFromJson(&Config, "config.json", ".")
 	if err != nil {
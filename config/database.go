@@ -1,26 +1,34 @@
 package config
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
 	"net/url"
+	"os"
+	"strconv"
 	"time"
+	"user-service/constants"
 
+	"github.com/glebarez/sqlite"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
+// nama konfigurasi TLS yang didaftarkan ke driver MySQL
+const mysqlTLSConfig = "user-service"
+
 func InitDatabase() (*gorm.DB, error) {
 	config := Config
-	encodedPassword := url.QueryEscape(config.Database.Password)
-	uri := fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable",
-		config.Database.UserName,
-		encodedPassword,
-		config.Database.Host,
-		config.Database.Port,
-		config.Database.Name,
-	)
-
-	db, err := gorm.Open(postgres.Open(uri), &gorm.Config{})
+	dialector, err := openDialector(config.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -28,9 +36,165 @@ func InitDatabase() (*gorm.DB, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// database SQLite di memori hilang saat koneksinya ditutup, jadi hanya dipakai
+	// satu koneksi yang tidak pernah kedaluwarsa
+	if config.Database.Driver == constants.DatabaseSQLite && config.Database.Name == constants.SQLiteMemory {
+		sqlDB.SetMaxOpenConns(1)
+		return db, nil
+	}
 	sqlDB.SetMaxIdleConns(config.Database.MaxIdleConnections)
 	sqlDB.SetMaxOpenConns(config.Database.MaxOpenConnections)
 	sqlDB.SetConnMaxLifetime(time.Duration(config.Database.MaxLifeTimeConnection) * time.Second)
 	sqlDB.SetConnMaxIdleTime(time.Duration(config.Database.MaxIdleTime) * time.Second)
 	return db, nil
 }
+
+// openDialector memilih dialector sesuai driver; driver kosong berarti Postgres
+func openDialector(database Database) (gorm.Dialector, error) {
+	switch database.Driver {
+	case "", constants.DatabasePostgres:
+		return postgres.Open(postgresDSN(database)), nil
+	case constants.DatabaseMySQL:
+		dsn, err := mysqlDSN(database)
+		if err != nil {
+			return nil, err
+		}
+		return mysqlDialector{mysql.Open(dsn).(*mysql.Dialector)}, nil
+	case constants.DatabaseSQLite:
+		return sqliteDialector{sqlite.Open(sqliteDSN(database)).(*sqlite.Dialector)}, nil
+	}
+	return nil, fmt.Errorf("unsupported database driver %q", database.Driver)
+}
+
+// postgresDSN membentuk URI Postgres. SSL mode default disable; opsi sertifikat
+// diteruskan apa adanya ke driver.
+func postgresDSN(database Database) string {
+	query := url.Values{}
+	query.Set("sslmode", "disable")
+	if database.SSLMode != "" {
+		query.Set("sslmode", database.SSLMode)
+	}
+	for key, value := range map[string]string{
+		"sslrootcert": database.SSLRootCert,
+		"sslcert":     database.SSLCert,
+		"sslkey":      database.SSLKey,
+		"sslpassword": database.SSLPassword,
+	} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	uri := url.URL{
+		Scheme:   "postgresql",
+		User:     url.UserPassword(database.UserName, database.Password),
+		Host:     net.JoinHostPort(database.Host, strconv.Itoa(database.Port)),
+		Path:     database.Name,
+		RawQuery: query.Encode(),
+	}
+	return uri.String()
+}
+
+// mysqlDSN membentuk DSN MySQL. Waktu disimpan dalam UTC agar tidak bergantung pada
+// zona waktu server database.
+func mysqlDSN(database Database) (string, error) {
+	config := mysqlDriver.NewConfig()
+	config.User = database.UserName
+	config.Passwd = database.Password
+	config.Net = "tcp"
+	config.Addr = net.JoinHostPort(database.Host, strconv.Itoa(database.Port))
+	config.DBName = database.Name
+	config.ParseTime = true
+	config.Loc = time.UTC
+	config.Params = map[string]string{"charset": "utf8mb4"}
+
+	tlsConfig, err := mysqlTLS(database)
+	if err != nil {
+		return "", err
+	}
+	config.TLSConfig = tlsConfig
+	return config.FormatDSN(), nil
+}
+
+// mysqlTLS menerjemahkan SSL mode ala Postgres ke opsi TLS driver MySQL
+func mysqlTLS(database Database) (string, error) {
+	switch database.SSLMode {
+	case "", "disable":
+		return "false", nil
+	case "allow", "prefer":
+		return "preferred", nil
+	case "require", "verify-ca", "verify-full":
+	default:
+		return "", fmt.Errorf("unsupported database ssl mode %q", database.SSLMode)
+	}
+
+	tlsConfig := &tls.Config{ServerName: database.Host}
+	if database.SSLCert != "" || database.SSLKey != "" {
+		certificate, err := tls.LoadX509KeyPair(database.SSLCert, database.SSLKey)
+		if err != nil {
+			return "", err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	if database.SSLRootCert != "" {
+		pem, err := os.ReadFile(database.SSLRootCert)
+		if err != nil {
+			return "", err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return "", fmt.Errorf("no certificate found in %s", database.SSLRootCert)
+		}
+	}
+
+	// require hanya mengenkripsi koneksi, verify-ca memeriksa sertifikat tanpa nama host,
+	// dan verify-full memeriksa keduanya
+	switch database.SSLMode {
+	case "require":
+		tlsConfig.InsecureSkipVerify = true
+	case "verify-ca":
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = verifyChain(tlsConfig.RootCAs)
+	}
+
+	if err := mysqlDriver.RegisterTLSConfig(mysqlTLSConfig, tlsConfig); err != nil {
+		return "", err
+	}
+	return mysqlTLSConfig, nil
+}
+
+// verifyChain memeriksa rantai sertifikat server terhadap roots tanpa mencocokkan nama host
+func verifyChain(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		certificates := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, raw := range rawCerts {
+			certificate, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certificates = append(certificates, certificate)
+		}
+		if len(certificates) == 0 {
+			return fmt.Errorf("server sent no certificate")
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, certificate := range certificates[1:] {
+			intermediates.AddCert(certificate)
+		}
+		_, err := certificates[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+		return err
+	}
+}
+
+// sqliteDSN membentuk DSN SQLite dari nama database, berupa path file atau ":memory:".
+// Foreign key diaktifkan dan transaksi langsung mengambil write lock agar penulis yang
+// bersamaan menunggu busy timeout alih-alih gagal.
+func sqliteDSN(database Database) string {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", "busy_timeout(5000)")
+	query.Set("_txlock", "immediate")
+	return database.Name + "?" + query.Encode()
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// tipe kolom pada tag model memakai tipe Postgres (uuid, jsonb). Dialector di bawah
+// menerjemahkannya ke tipe padanan saat membuat tabel di MySQL dan SQLite.
+var (
+	mysqlTypes  = map[schema.DataType]string{"uuid": "char(36)", "jsonb": "json"}
+	sqliteTypes = map[schema.DataType]string{"uuid": "text", "jsonb": "text"}
+)
+
+type mysqlDialector struct {
+	*mysql.Dialector
+}
+
+func (d mysqlDialector) DataTypeOf(field *schema.Field) string {
+	if dataType, ok := mysqlTypes[field.DataType]; ok {
+		return dataType
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+func (d mysqlDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	migrator := d.Dialector.Migrator(db).(mysql.Migrator)
+	migrator.Migrator.Dialector = d
+	return migrator
+}
+
+type sqliteDialector struct {
+	*sqlite.Dialector
+}
+
+func (d sqliteDialector) DataTypeOf(field *schema.Field) string {
+	if dataType, ok := sqliteTypes[field.DataType]; ok {
+		return dataType
+	}
+	return d.Dialector.DataTypeOf(field)
+}
+
+func (d sqliteDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	migrator := d.Dialector.Migrator(db).(sqlite.Migrator)
+	migrator.Migrator.Dialector = d
+	return migrator
+}
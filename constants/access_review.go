@@ -0,0 +1,12 @@
+package constants
+
+const (
+	AccessReviewOpen   = "open"
+	AccessReviewClosed = "closed"
+)
+
+const (
+	DecisionPending = "pending"
+	DecisionKeep    = "keep"
+	DecisionRevoke  = "revoke"
+)
@@ -0,0 +1,12 @@
+package constants
+
+const (
+	AttributeString  = "string"
+	AttributeNumber  = "number"
+	AttributeBoolean = "boolean"
+	AttributeDate    = "date"
+	AttributeEnum    = "enum"
+)
+
+// AttributeDateLayout adalah format nilai atribut bertipe date
+const AttributeDateLayout = "2006-01-02"
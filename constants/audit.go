@@ -0,0 +1,67 @@
+package constants
+
+const (
+	ActorUser    = "user"
+	ActorService = "service"
+	ActorSystem  = "system"
+)
+
+// AuditRedacted menggantikan nilai field rahasia dan data pribadi pada diff audit
+const AuditRedacted = "[REDACTED]"
+
+// AuditRedactedFields adalah field yang nilainya tidak pernah ditulis ke audit log: rahasia,
+// data pribadi, dan teks bebas yang harus ikut hilang saat erasure walaupun audit log tidak
+// bisa diubah
+var AuditRedactedFields = []string{"password", "name", "username", "email", "phoneNumber", "suspendedReason"}
+
+// AuditAttributePrefix adalah awalan field atribut profil pada diff audit. Nilai atribut
+// selalu disamarkan karena bisa berisi data pribadi.
+const AuditAttributePrefix = "attributes."
+
+const (
+	AuditElevationRequested = "role.elevation.requested"
+	AuditElevationApproved  = "role.elevation.approved"
+	AuditElevationRejected  = "role.elevation.rejected"
+	AuditElevationExpired   = "role.elevation.expired"
+
+	AuditAccessReviewCreated = "access_review.created"
+	AuditAccessReviewClosed  = "access_review.closed"
+	AuditRoleRevoked         = "role.revoked"
+
+	AuditUserRegistered    = "user.registered"
+	AuditUserUpdated       = "user.updated"
+	AuditUserStatusChanged = "user.status.changed"
+	AuditAvatarUpdated     = "user.avatar.updated"
+	AuditAvatarDeleted     = "user.avatar.deleted"
+	AuditTokensRevoked     = "user.tokens.revoked"
+
+	AuditMemberSaved   = "organization.member.saved"
+	AuditMemberRemoved = "organization.member.removed"
+
+	AuditGroupMemberAdded   = "group.member.added"
+	AuditGroupMemberRemoved = "group.member.removed"
+
+	AuditErasureRequested = "user.erasure.requested"
+	AuditErasureCancelled = "user.erasure.cancelled"
+	AuditErasureCompleted = "user.erasure.completed"
+	AuditDataExported     = "user.data.exported"
+
+	AuditUsersImported = "user.imported"
+	AuditUsersExported = "user.exported"
+
+	AuditEmailChangeRequested = "user.email_change.requested"
+	AuditEmailChangeConfirmed = "user.email_change.confirmed"
+	AuditEmailChangeCancelled = "user.email_change.cancelled"
+
+	AuditInvitationCreated  = "invitation.created"
+	AuditInvitationResent   = "invitation.resent"
+	AuditInvitationRevoked  = "invitation.revoked"
+	AuditInvitationAccepted = "invitation.accepted"
+)
+
+const (
+	SubjectUser       = "user"
+	SubjectElevation  = "role_elevation"
+	SubjectReview     = "access_review"
+	SubjectInvitation = "invitation"
+)
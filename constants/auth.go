@@ -3,7 +3,17 @@ package constants
 type ContextKey string
 
 const (
-	UserLogin ContextKey = "UserLogin"
-	Token     ContextKey = "token"
+	UserLogin      ContextKey = "UserLogin"
+	Token          ContextKey = "token"
+	Tenant         ContextKey = "Tenant"
+	UserGroup      ContextKey = "UserGroup"
+	Scope          ContextKey = "Scope"
+	TokenExpiresAt ContextKey = "TokenExpiresAt"
+	TokenIssuedAt  ContextKey = "TokenIssuedAt"
+	RequestID      ContextKey = "RequestID"
+	ClientIP       ContextKey = "ClientIP"
+	ServiceName    ContextKey = "ServiceName"
+	UserAgent      ContextKey = "UserAgent"
+	Locale         ContextKey = "Locale"
+	Location       ContextKey = "Location"
 )
-
@@ -0,0 +1,25 @@
+package constants
+
+const (
+	AvatarOriginal = "original"
+	AvatarSmall    = "small"
+	AvatarMedium   = "medium"
+	AvatarLarge    = "large"
+
+	// AvatarMaxPixels membatasi dimensi gambar yang boleh di-decode
+	AvatarMaxPixels = 4096 * 4096
+)
+
+// AvatarSizes adalah ukuran thumbnail (piksel, persegi) yang dibuat saat upload
+var AvatarSizes = map[string]int{
+	AvatarSmall:  64,
+	AvatarMedium: 128,
+	AvatarLarge:  256,
+}
+
+// AvatarContentTypes adalah tipe konten hasil sniffing yang diterima beserta ekstensinya
+var AvatarContentTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
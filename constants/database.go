@@ -0,0 +1,19 @@
+package constants
+
+// nama unique index pada tabel users, dipakai untuk menerjemahkan pelanggaran unique
+// menjadi error yang bisa dibaca client
+const (
+	UsersUsernameUniqueIndex = "idx_users_tenant_username"
+	UsersEmailUniqueIndex    = "idx_users_tenant_email"
+	UsersUUIDUniqueIndex     = "idx_users_uuid"
+)
+
+// driver database yang didukung, sama dengan nama dialector gorm masing-masing
+const (
+	DatabasePostgres = "postgres"
+	DatabaseMySQL    = "mysql"
+	DatabaseSQLite   = "sqlite"
+)
+
+// SQLiteMemory adalah nama database SQLite yang hanya disimpan di memori
+const SQLiteMemory = ":memory:"
@@ -0,0 +1,12 @@
+package constants
+
+const (
+	ElevationPending  = "pending"
+	ElevationApproved = "approved"
+	ElevationRejected = "rejected"
+	ElevationExpired  = "expired"
+)
+
+// DefaultElevationSweepSecond adalah interval pencabutan elevasi kedaluwarsa jika
+// elevationSweepSecond tidak diatur; nilai negatif menonaktifkan pencabutan
+const DefaultElevationSweepSecond = 60
@@ -0,0 +1,9 @@
+package constants
+
+const (
+	EmailChangePending   = "pending"
+	EmailChangeConfirmed = "confirmed"
+	EmailChangeCancelled = "cancelled"
+)
+
+const DefaultEmailChangeExpireMinutes = 24 * 60
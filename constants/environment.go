@@ -0,0 +1,16 @@
+package constants
+
+const (
+	EnvProduction  = "production"
+	EnvStaging     = "staging"
+	EnvDevelopment = "development"
+)
+
+// DefaultEnvironment dipakai jika environment tidak diatur, sehingga seeding tanpa
+// konfigurasi tidak pernah membuat data contoh
+const DefaultEnvironment = EnvProduction
+
+const (
+	DefaultSeedAdminUsername = "admin"
+	DefaultSeedAdminName     = "Administrator"
+)
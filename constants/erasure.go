@@ -0,0 +1,18 @@
+package constants
+
+const (
+	ErasurePending   = "pending"
+	ErasureCancelled = "cancelled"
+	ErasureCompleted = "completed"
+)
+
+// DefaultErasureGraceDays adalah masa tenggang sebelum akun dihapus jika erasureGraceDays
+// tidak diatur, sehingga user selalu punya waktu untuk membatalkan
+const DefaultErasureGraceDays = 30
+
+// ErasedValue menggantikan teks bebas yang mungkin berisi data pribadi
+const ErasedValue = "[erased]"
+
+// DefaultErasureSweepSecond adalah interval pemrosesan erasure yang jatuh tempo jika
+// erasureSweepSecond tidak diatur; nilai negatif menonaktifkan pemrosesan
+const DefaultErasureSweepSecond = 3600
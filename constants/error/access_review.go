@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrAccessReviewNotFound     = errors.New("access review not found")
+	ErrAccessReviewClosed       = errors.New("access review already closed")
+	ErrAccessReviewNotClosed    = errors.New("access review is not closed yet")
+	ErrAccessReviewIncomplete   = errors.New("access review still has undecided items")
+	ErrAccessReviewItemNotFound = errors.New("access review item not found")
+	ErrInvalidExportFormat      = errors.New("invalid export format")
+)
+
+var AccessReviewError = []error{
+	ErrAccessReviewNotFound,
+	ErrAccessReviewClosed,
+	ErrAccessReviewNotClosed,
+	ErrAccessReviewIncomplete,
+	ErrAccessReviewItemNotFound,
+	ErrInvalidExportFormat,
+}
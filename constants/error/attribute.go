@@ -0,0 +1,21 @@
+package error
+
+import "errors"
+
+var (
+	ErrAttributeNotFound = errors.New("attribute not found")
+	ErrAttributeExist    = errors.New("attribute key already exist")
+	ErrInvalidAttributes = errors.New("invalid attributes")
+	ErrInvalidKey        = errors.New("attribute key must start with a letter and contain only letters, digits or underscores")
+	ErrInvalidPattern    = errors.New("invalid attribute pattern")
+	ErrAttributeOptions  = errors.New("enum attribute requires options")
+)
+
+var AttributeError = []error{
+	ErrAttributeNotFound,
+	ErrAttributeExist,
+	ErrInvalidAttributes,
+	ErrInvalidKey,
+	ErrInvalidPattern,
+	ErrAttributeOptions,
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrAuditLogImmutable = errors.New("audit log cannot be changed")
+)
+
+var AuditError = []error{
+	ErrAuditLogImmutable,
+}
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrAvatarRequired    = errors.New("avatar file is required")
+	ErrAvatarTooLarge    = errors.New("avatar file is too large")
+	ErrAvatarInvalidType = errors.New("avatar must be a jpeg, png or gif image")
+	ErrAvatarNotFound    = errors.New("avatar not found")
+)
+
+var AvatarError = []error{
+	ErrAvatarRequired,
+	ErrAvatarTooLarge,
+	ErrAvatarInvalidType,
+	ErrAvatarNotFound,
+}
@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrElevationNotFound     = errors.New("role elevation not found")
+	ErrElevationNotPending   = errors.New("role elevation is not pending")
+	ErrElevationSelfApproval = errors.New("role elevation cannot be reviewed by the requester")
+	ErrElevationDuration     = errors.New("role elevation duration exceeds the allowed maximum")
+	ErrRoleAlreadyAssigned   = errors.New("role already assigned")
+)
+
+var ElevationError = []error{
+	ErrElevationNotFound,
+	ErrElevationNotPending,
+	ErrElevationSelfApproval,
+	ErrElevationDuration,
+	ErrRoleAlreadyAssigned,
+}
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrEmailChangeNotFound = errors.New("email change request not found")
+	ErrEmailChangeInvalid  = errors.New("email change token is invalid or expired")
+	ErrEmailChangeRequired = errors.New("email can only be changed through the email change flow")
+	ErrEmailUnchanged      = errors.New("new email is the same as the current email")
+)
+
+var EmailChangeError = []error{
+	ErrEmailChangeNotFound,
+	ErrEmailChangeInvalid,
+	ErrEmailChangeRequired,
+	ErrEmailUnchanged,
+}
@@ -3,6 +3,17 @@ package error
 func ErrMapping(err error) bool {
 	allErrors := append([]error{}, GeneralError...)
 	allErrors = append(allErrors, UserError...)
+	allErrors = append(allErrors, OrganizationError...)
+	allErrors = append(allErrors, GroupError...)
+	allErrors = append(allErrors, ElevationError...)
+	allErrors = append(allErrors, AccessReviewError...)
+	allErrors = append(allErrors, PrivacyError...)
+	allErrors = append(allErrors, AvatarError...)
+	allErrors = append(allErrors, AttributeError...)
+	allErrors = append(allErrors, ImportError...)
+	allErrors = append(allErrors, EmailChangeError...)
+	allErrors = append(allErrors, AuditError...)
+	allErrors = append(allErrors, InvitationError...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
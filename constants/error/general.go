@@ -13,7 +13,13 @@ var (
 	ErrToManyRequest       = errors.New("too many requests")
 	ErrUnauthorized        = errors.New("unauthorized")
 	ErrInvalidToken        = errors.New("invalid token")
+	ErrTokenRevoked        = errors.New("token has been revoked")
 	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidScope        = errors.New("invalid scope")
+	ErrInsufficientScope   = errors.New("insufficient scope")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidPatch        = errors.New("request body must be a JSON object")
+	ErrUnsupportedMedia    = errors.New("unsupported content type")
 )
 
 var GeneralError=[]error{
@@ -22,5 +28,11 @@ var GeneralError=[]error{
 	ErrToManyRequest,
 	ErrUnauthorized,
 	ErrInvalidToken,
+	ErrTokenRevoked,
 	ErrForbidden,
+	ErrInvalidScope,
+	ErrInsufficientScope,
+	ErrInvalidCursor,
+	ErrInvalidPatch,
+	ErrUnsupportedMedia,
 }
@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrGroupNotFound       = errors.New("group not found")
+	ErrGroupMemberExist    = errors.New("group member exist")
+	ErrGroupMemberNotFound = errors.New("group member not found")
+)
+
+var GroupError = []error{
+	ErrGroupNotFound,
+	ErrGroupMemberExist,
+	ErrGroupMemberNotFound,
+}
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrImportFormat = errors.New("import format must be csv or json")
+	ErrImportHeader = errors.New("import file has an invalid header")
+	ErrImportFile   = errors.New("import file could not be parsed")
+	ErrExportFormat = errors.New("export format must be csv or jsonl")
+)
+
+var ImportError = []error{
+	ErrImportFormat,
+	ErrImportHeader,
+	ErrImportFile,
+	ErrExportFormat,
+}
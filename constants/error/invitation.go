@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrInvitationNotFound   = errors.New("invitation not found")
+	ErrInvitationInvalid    = errors.New("invitation token is invalid or expired")
+	ErrInvitationExist      = errors.New("a pending invitation already exists for this email")
+	ErrInvitationNotPending = errors.New("invitation is no longer pending")
+)
+
+var InvitationError = []error{
+	ErrInvitationNotFound,
+	ErrInvitationInvalid,
+	ErrInvitationExist,
+	ErrInvitationNotPending,
+}
@@ -0,0 +1,19 @@
+package error
+
+import "errors"
+
+var (
+	ErrOrganizationNotFound  = errors.New("organization not found")
+	ErrOrganizationCodeExist = errors.New("organization code exist")
+	ErrInvalidOrganization   = errors.New("invalid organization")
+	ErrMemberNotFound        = errors.New("organization member not found")
+	ErrRoleNotFound          = errors.New("role not found")
+)
+
+var OrganizationError = []error{
+	ErrOrganizationNotFound,
+	ErrOrganizationCodeExist,
+	ErrInvalidOrganization,
+	ErrMemberNotFound,
+	ErrRoleNotFound,
+}
@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrErasureNotFound = errors.New("erasure request not found")
+	ErrErasureExist    = errors.New("erasure request already pending")
+)
+
+var PrivacyError = []error{
+	ErrErasureNotFound,
+	ErrErasureExist,
+}
@@ -8,11 +8,22 @@ var (
 	ErrUsernameExist        = errors.New("username exist")
 	ErrEmailExist        = errors.New("email exist")
 	ErrPasswordDoesNotMatch = errors.New("password does not match")
+	ErrUserSuspended        = errors.New("user suspended")
+	ErrUserDeactivated      = errors.New("user deactivated")
+	ErrUserDeleted          = errors.New("user deleted")
+	ErrInvalidStatusChange  = errors.New("invalid user status change")
+	ErrVersionConflict      = errors.New("user has been modified, version does not match")
 )
 
 var UserError = []error{
 	ErrUserNotFound,
 	ErrPasswordIncorrect,
 	ErrUsernameExist,
+	ErrEmailExist,
 	ErrPasswordDoesNotMatch,
+	ErrUserSuspended,
+	ErrUserDeactivated,
+	ErrUserDeleted,
+	ErrInvalidStatusChange,
+	ErrVersionConflict,
 }
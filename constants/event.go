@@ -0,0 +1,17 @@
+package constants
+
+const (
+	EventUserDeletionScheduled = "user.deletion.scheduled"
+	EventUserDeletionCancelled = "user.deletion.cancelled"
+	EventUserDeleted           = "user.deleted"
+)
+
+// DefaultOutboxSweepSecond adalah interval pengiriman event outbox jika outboxSweepSecond
+// tidak diatur; nilai negatif menonaktifkan pengiriman
+const DefaultOutboxSweepSecond = 10
+
+const (
+	OutboxBatchSize = 100
+	// OutboxMaxBackoffSecond membatasi jeda percobaan ulang event yang gagal dikirim
+	OutboxMaxBackoffSecond = 3600
+)
@@ -0,0 +1,8 @@
+package constants
+
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatJSONL = "jsonl"
+)
+
+const ExportBatchSize = 500
@@ -7,4 +7,13 @@ var (
 	XApiKey       = textproto.CanonicalMIMEHeaderKey("x-Api-Key")
 	XRequestAt    = textproto.CanonicalMIMEHeaderKey("x-Request-At")
 	Authorization = textproto.CanonicalMIMEHeaderKey("authorization")
+	XOrganization = textproto.CanonicalMIMEHeaderKey("x-Organization-Id")
+	ETag          = textproto.CanonicalMIMEHeaderKey("etag")
+	IfMatch       = textproto.CanonicalMIMEHeaderKey("if-Match")
+	XRequestID    = textproto.CanonicalMIMEHeaderKey("x-Request-Id")
+	XSignature    = textproto.CanonicalMIMEHeaderKey("x-Signature")
+	XTimezone     = textproto.CanonicalMIMEHeaderKey("x-Timezone")
+	AcceptLang    = textproto.CanonicalMIMEHeaderKey("accept-Language")
 )
+
+const ContentTypeMergePatch = "application/merge-patch+json"
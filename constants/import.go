@@ -0,0 +1,15 @@
+package constants
+
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+)
+
+// Status baris import; pada dry run, created berarti baris akan dibuat
+const (
+	ImportCreated = "created"
+	ImportSkipped = "skipped"
+	ImportFailed  = "failed"
+)
+
+const ImportDefaultBatchSize = 500
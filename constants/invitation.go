@@ -0,0 +1,11 @@
+package constants
+
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationRevoked  = "revoked"
+	// InvitationExpired hanya dipakai pada response untuk undangan pending yang sudah lewat masa berlakunya
+	InvitationExpired = "expired"
+)
+
+const DefaultInvitationExpireMinutes = 7 * 24 * 60
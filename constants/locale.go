@@ -0,0 +1,14 @@
+package constants
+
+const (
+	LocaleEnglish    = "en"
+	LocaleIndonesian = "id"
+)
+
+// Locales adalah bahasa yang didukung untuk pesan response dan notifikasi
+var Locales = []string{LocaleEnglish, LocaleIndonesian}
+
+const (
+	DefaultLocale   = LocaleEnglish
+	DefaultTimezone = "Asia/Jakarta"
+)
@@ -0,0 +1,13 @@
+package constants
+
+const LoginMethodPassword = "password"
+
+// alasan kegagalan login yang disimpan pada riwayat login
+const (
+	LoginFailedUnknownUser     = "unknown_user"
+	LoginFailedInvalidPassword = "invalid_password"
+	LoginFailedUserStatus      = "user_status"
+	LoginFailedError           = "error"
+)
+
+const DefaultLoginHistoryRetentionDays = 90
@@ -4,3 +4,8 @@ const (
 	Admin    = 1
 	Customer = 2
 )
+
+const (
+	RoleAdmin    = "admin"
+	RoleCustomer = "customer"
+)
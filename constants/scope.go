@@ -0,0 +1,18 @@
+package constants
+
+const (
+	ScopeProfileRead  = "profile:read"
+	ScopeProfileWrite = "profile:write"
+	ScopeUserRead     = "user:read"
+	ScopeUserWrite    = "user:write"
+	ScopeAdmin        = "admin"
+)
+
+// Scopes adalah seluruh scope yang dikenal; token tanpa permintaan scope mendapat semuanya
+var Scopes = []string{
+	ScopeProfileRead,
+	ScopeProfileWrite,
+	ScopeUserRead,
+	ScopeUserWrite,
+	ScopeAdmin,
+}
@@ -0,0 +1,15 @@
+package constants
+
+const (
+	UserActive      = "active"
+	UserSuspended   = "suspended"
+	UserDeactivated = "deactivated"
+	UserDeleted     = "deleted"
+)
+
+// UserStatusTransitions adalah perpindahan status user yang diizinkan; deleted bersifat final
+var UserStatusTransitions = map[string][]string{
+	UserActive:      {UserSuspended, UserDeactivated, UserDeleted},
+	UserSuspended:   {UserActive, UserSuspended, UserDeactivated, UserDeleted},
+	UserDeactivated: {UserActive, UserDeleted},
+}
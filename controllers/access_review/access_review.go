@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AccessReviewController struct {
+	service services.IServiceRegistry
+}
+
+type IAccessReviewController interface {
+	Create(*gin.Context)
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Decide(*gin.Context)
+	Close(*gin.Context)
+	Export(*gin.Context)
+}
+
+func NewAccessReviewController(service services.IServiceRegistry) IAccessReviewController {
+	return &AccessReviewController{service: service}
+}
+
+func (c *AccessReviewController) Create(ctx *gin.Context) {
+	request := &dto.AccessReviewRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	review, err := c.service.GetAccessReview().Create(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusCreated,
+		Data: review,
+		Gin:  ctx,
+	})
+}
+
+func (c *AccessReviewController) GetAll(ctx *gin.Context) {
+	reviews, err := c.service.GetAccessReview().GetAll(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: reviews,
+		Gin:  ctx,
+	})
+}
+
+func (c *AccessReviewController) GetByUUID(ctx *gin.Context) {
+	review, err := c.service.GetAccessReview().GetByUUID(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: review,
+		Gin:  ctx,
+	})
+}
+
+func (c *AccessReviewController) Decide(ctx *gin.Context) {
+	request := &dto.AccessReviewDecisionRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	review, err := c.service.GetAccessReview().Decide(ctx.Request.Context(), ctx.Param("uuid"), ctx.Param("itemUUID"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: review,
+		Gin:  ctx,
+	})
+}
+
+func (c *AccessReviewController) Close(ctx *gin.Context) {
+	review, err := c.service.GetAccessReview().Close(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: review,
+		Gin:  ctx,
+	})
+}
+
+func (c *AccessReviewController) Export(ctx *gin.Context) {
+	request := &dto.AccessReviewExportRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	file, err := c.service.GetAccessReview().Export(ctx.Request.Context(), ctx.Param("uuid"), request.Format)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	ctx.Data(http.StatusOK, file.ContentType, file.Content)
+}
@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AttributeController struct {
+	service services.IServiceRegistry
+}
+
+type IAttributeController interface {
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+}
+
+func NewAttributeController(service services.IServiceRegistry) IAttributeController {
+	return &AttributeController{service: service}
+}
+
+func (c *AttributeController) Create(ctx *gin.Context) {
+	request := &dto.AttributeDefinitionRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	attribute, err := c.service.GetAttribute().Create(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusCreated,
+		Data: attribute,
+		Gin:  ctx,
+	})
+}
+
+func (c *AttributeController) Update(ctx *gin.Context) {
+	request := &dto.AttributeDefinitionRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	attribute, err := c.service.GetAttribute().Update(ctx.Request.Context(), request, ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: attribute,
+		Gin:  ctx,
+	})
+}
+
+func (c *AttributeController) Delete(ctx *gin.Context) {
+	err := c.service.GetAttribute().Delete(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+func (c *AttributeController) GetAll(ctx *gin.Context) {
+	attributes, err := c.service.GetAttribute().GetAll(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: attributes,
+		Gin:  ctx,
+	})
+}
+
+func (c *AttributeController) GetByUUID(ctx *gin.Context) {
+	attribute, err := c.service.GetAttribute().GetByUUID(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: attribute,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuditController struct {
+	service services.IServiceRegistry
+}
+
+type IAuditController interface {
+	GetAll(*gin.Context)
+}
+
+func NewAuditController(service services.IServiceRegistry) IAuditController {
+	return &AuditController{service: service}
+}
+
+func (c *AuditController) GetAll(ctx *gin.Context) {
+	request := &dto.AuditLogFilter{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	auditLogs, meta, err := c.service.GetAudit().GetAll(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: auditLogs,
+		Meta: meta,
+		Gin:  ctx,
+	})
+}
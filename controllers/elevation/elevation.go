@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ElevationController struct {
+	service services.IServiceRegistry
+}
+
+type IElevationController interface {
+	Request(*gin.Context)
+	GetAll(*gin.Context)
+	Approve(*gin.Context)
+	Reject(*gin.Context)
+}
+
+func NewElevationController(service services.IServiceRegistry) IElevationController {
+	return &ElevationController{service: service}
+}
+
+func (c *ElevationController) Request(ctx *gin.Context) {
+	request := &dto.ElevationRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	elevation, err := c.service.GetElevation().Request(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusCreated,
+		Data: elevation,
+		Gin:  ctx,
+	})
+}
+
+func (c *ElevationController) GetAll(ctx *gin.Context) {
+	filter := &dto.ElevationFilter{}
+	if err := ctx.ShouldBindQuery(filter); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	elevations, err := c.service.GetElevation().GetAll(ctx.Request.Context(), filter)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: elevations,
+		Gin:  ctx,
+	})
+}
+
+func (c *ElevationController) Approve(ctx *gin.Context) {
+	elevation, err := c.service.GetElevation().Approve(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: elevation,
+		Gin:  ctx,
+	})
+}
+
+func (c *ElevationController) Reject(ctx *gin.Context) {
+	elevation, err := c.service.GetElevation().Reject(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: elevation,
+		Gin:  ctx,
+	})
+}
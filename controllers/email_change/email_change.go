@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type EmailChangeController struct {
+	service services.IServiceRegistry
+}
+
+type IEmailChangeController interface {
+	Request(*gin.Context)
+	GetPending(*gin.Context)
+	Cancel(*gin.Context)
+	Confirm(*gin.Context)
+}
+
+func NewEmailChangeController(service services.IServiceRegistry) IEmailChangeController {
+	return &EmailChangeController{service: service}
+}
+
+func (c *EmailChangeController) Request(ctx *gin.Context) {
+	request := &dto.EmailChangeRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	change, err := c.service.GetEmailChange().Request(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusAccepted,
+		Data: change,
+		Gin:  ctx,
+	})
+}
+
+func (c *EmailChangeController) GetPending(ctx *gin.Context) {
+	change, err := c.service.GetEmailChange().GetPending(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: change,
+		Gin:  ctx,
+	})
+}
+
+func (c *EmailChangeController) Cancel(ctx *gin.Context) {
+	change, err := c.service.GetEmailChange().Cancel(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: change,
+		Gin:  ctx,
+	})
+}
+
+func (c *EmailChangeController) Confirm(ctx *gin.Context) {
+	request := &dto.EmailChangeConfirmRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	change, err := c.service.GetEmailChange().Confirm(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: change,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type PrivacyController struct {
+	service services.IServiceRegistry
+}
+
+type IPrivacyController interface {
+	Export(*gin.Context)
+	RequestErasure(*gin.Context)
+	DeleteAccount(*gin.Context)
+	GetErasure(*gin.Context)
+	CancelErasure(*gin.Context)
+}
+
+func NewPrivacyController(service services.IServiceRegistry) IPrivacyController {
+	return &PrivacyController{service: service}
+}
+
+func (c *PrivacyController) Export(ctx *gin.Context) {
+	file, err := c.service.GetPrivacy().Export(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Name))
+	ctx.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (c *PrivacyController) RequestErasure(ctx *gin.Context) {
+	request := &dto.ErasureRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	erasure, err := c.service.GetPrivacy().RequestErasure(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusAccepted,
+		Data: erasure,
+		Gin:  ctx,
+	})
+}
+
+func (c *PrivacyController) DeleteAccount(ctx *gin.Context) {
+	request := &dto.ErasureRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	erasure, err := c.service.GetPrivacy().DeleteAccount(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusAccepted,
+		Data: erasure,
+		Gin:  ctx,
+	})
+}
+
+func (c *PrivacyController) GetErasure(ctx *gin.Context) {
+	erasure, err := c.service.GetPrivacy().GetErasure(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: erasure,
+		Gin:  ctx,
+	})
+}
+
+func (c *PrivacyController) CancelErasure(ctx *gin.Context) {
+	erasure, err := c.service.GetPrivacy().CancelErasure(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: erasure,
+		Gin:  ctx,
+	})
+}
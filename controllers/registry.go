@@ -1,7 +1,17 @@
 package controllers
 
 import (
+	accessReviewControllers "user-service/controllers/access_review"
+	attributeControllers "user-service/controllers/attribute"
+	auditControllers "user-service/controllers/audit"
+	elevationControllers "user-service/controllers/elevation"
+	emailChangeControllers "user-service/controllers/email_change"
+	groupControllers "user-service/controllers/group"
+	invitationControllers "user-service/controllers/invitation"
+	orgControllers "user-service/controllers/organization"
+	privacyControllers "user-service/controllers/privacy"
 	"user-service/controllers/user"
+	importControllers "user-service/controllers/user_import"
 	"user-service/services"
 )
 
@@ -11,6 +21,16 @@ type Registry struct {
 
 type IControllerRegistry interface {
 	GetUserController() controllers.IUserController
+	GetOrganizationController() orgControllers.IOrganizationController
+	GetGroupController() groupControllers.IGroupController
+	GetElevationController() elevationControllers.IElevationController
+	GetAccessReviewController() accessReviewControllers.IAccessReviewController
+	GetPrivacyController() privacyControllers.IPrivacyController
+	GetAttributeController() attributeControllers.IAttributeController
+	GetImportController() importControllers.IImportController
+	GetEmailChangeController() emailChangeControllers.IEmailChangeController
+	GetAuditController() auditControllers.IAuditController
+	GetInvitationController() invitationControllers.IInvitationController
 }
 
 func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistry {
@@ -19,4 +39,44 @@ func NewControllerRegistry(service services.IServiceRegistry) IControllerRegistr
 
 func (r *Registry) GetUserController() controllers.IUserController {
 	return controllers.NewUserController(r.service)
-}
\ No newline at end of file
+}
+
+func (r *Registry) GetOrganizationController() orgControllers.IOrganizationController {
+	return orgControllers.NewOrganizationController(r.service)
+}
+
+func (r *Registry) GetGroupController() groupControllers.IGroupController {
+	return groupControllers.NewGroupController(r.service)
+}
+
+func (r *Registry) GetElevationController() elevationControllers.IElevationController {
+	return elevationControllers.NewElevationController(r.service)
+}
+
+func (r *Registry) GetAccessReviewController() accessReviewControllers.IAccessReviewController {
+	return accessReviewControllers.NewAccessReviewController(r.service)
+}
+
+func (r *Registry) GetPrivacyController() privacyControllers.IPrivacyController {
+	return privacyControllers.NewPrivacyController(r.service)
+}
+
+func (r *Registry) GetAttributeController() attributeControllers.IAttributeController {
+	return attributeControllers.NewAttributeController(r.service)
+}
+
+func (r *Registry) GetImportController() importControllers.IImportController {
+	return importControllers.NewImportController(r.service)
+}
+
+func (r *Registry) GetEmailChangeController() emailChangeControllers.IEmailChangeController {
+	return emailChangeControllers.NewEmailChangeController(r.service)
+}
+
+func (r *Registry) GetAuditController() auditControllers.IAuditController {
+	return auditControllers.NewAuditController(r.service)
+}
+
+func (r *Registry) GetInvitationController() invitationControllers.IInvitationController {
+	return invitationControllers.NewInvitationController(r.service)
+}
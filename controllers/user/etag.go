@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"strconv"
+	"strings"
+	"user-service/constants"
+
+	"github.com/gin-gonic/gin"
+)
+
+// etag membentuk entity tag kuat dari versi user
+func etag(version uint) string {
+	return strconv.Quote(strconv.FormatUint(uint64(version), 10))
+}
+
+// ifMatch membaca versi yang diharapkan dari header If-Match. Versi nil berarti header
+// tidak dikirim atau bernilai "*"; ok false berarti header tidak bisa dicocokkan sama sekali.
+func ifMatch(ctx *gin.Context) (version *uint, ok bool) {
+	header := strings.TrimSpace(ctx.GetHeader(constants.IfMatch))
+	if header == "" || header == "*" {
+		return nil, true
+	}
+	// If-Match memakai perbandingan kuat, sehingga ETag lemah (W/) tidak pernah cocok
+	unquoted, err := strconv.Unquote(header)
+	if err != nil || strings.HasPrefix(header, "W/") {
+		return nil, false
+	}
+	parsed, err := strconv.ParseUint(unquoted, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	value := uint(parsed)
+	return &value, true
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/domain/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// bindLoginHistoryRequest membaca dan memvalidasi query riwayat login; false berarti response error sudah dikirim
+func bindLoginHistoryRequest(ctx *gin.Context) (*dto.LoginHistoryRequest, bool) {
+	request := &dto.LoginHistoryRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return nil, false
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return nil, false
+	}
+	return request, true
+}
+
+func (c *UserController) GetLoginHistory(ctx *gin.Context) {
+	request, ok := bindLoginHistoryRequest(ctx)
+	if !ok {
+		return
+	}
+
+	attempts, meta, err := c.userService.GetUser().GetLoginHistory(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: attempts,
+		Meta: meta,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) GetUserLoginHistory(ctx *gin.Context) {
+	request, ok := bindLoginHistoryRequest(ctx)
+	if !ok {
+		return
+	}
+
+	attempts, meta, err := c.userService.GetUser().GetUserLoginHistory(ctx.Request.Context(), ctx.Param("uuid"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: attempts,
+		Meta: meta,
+		Gin:  ctx,
+	})
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+)
+
+// patchFields adalah field yang boleh dikirim pada PATCH beserta apakah boleh bernilai null
+var patchFields = map[string]bool{
+	"name":            false,
+	"username":        false,
+	"email":           false,
+	"phoneNumber":     false,
+	"password":        false,
+	"confirmPassword": false,
+	"attributes":      true,
+	"locale":          false,
+	"timezone":        false,
+}
+
+// parseUserPatch membaca body JSON Merge Patch (RFC 7386). Field yang tidak dikenal dan
+// null pada field yang tidak boleh kosong dikembalikan sebagai kesalahan per field.
+func parseUserPatch(body []byte) (*dto.UserPatchRequest, []errWrap.ValidationResponse, error) {
+	document := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &document); err != nil || document == nil {
+		return nil, nil, errConstant.ErrInvalidPatch
+	}
+
+	fields := []errWrap.ValidationResponse{}
+	for _, key := range slices.Sorted(maps.Keys(document)) {
+		nullable, ok := patchFields[key]
+		switch {
+		case !ok:
+			fields = append(fields, errWrap.NewValidationResponse(key, "%s is not a patchable field", key))
+		case !nullable && string(document[key]) == "null":
+			fields = append(fields, errWrap.NewValidationResponse(key, "%s cannot be null", key))
+		}
+	}
+	if len(fields) > 0 {
+		return nil, fields, nil
+	}
+
+	request := &dto.UserPatchRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		return nil, nil, err
+	}
+	request.ClearAttributes = string(document["attributes"]) == "null"
+	return request, nil, nil
+}
@@ -1,14 +1,22 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"time"
 	"user-service/common/error"
 	"user-service/common/response"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
 	"user-service/domain/dto"
 	"user-service/services"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
 )
 
 type UserController struct {
@@ -17,10 +25,20 @@ type UserController struct {
 
 type IUserController interface {
 	Login(*gin.Context)
+	IssueToken(*gin.Context)
 	Register(*gin.Context)
 	Update(*gin.Context)
 	GetUserLogin(*gin.Context)
 	GetUserByUUID(*gin.Context)
+	GetAll(*gin.Context)
+	ChangeStatus(*gin.Context)
+	GetStatusHistory(*gin.Context)
+	UploadAvatar(*gin.Context)
+	DeleteAvatar(*gin.Context)
+	Export(*gin.Context)
+	Patch(*gin.Context)
+	GetLoginHistory(*gin.Context)
+	GetUserLoginHistory(*gin.Context)
 }
 
 func NewUserController(userService services.IServiceRegistry) IUserController {
@@ -52,7 +70,56 @@ func (c *UserController) Login(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.userService.GetUser().Login(ctx, request)
+	user, err := c.userService.GetUser().Login(ctx.Request.Context(), request)
+	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, errConstant.ErrUserSuspended) ||
+			errors.Is(err, errConstant.ErrUserDeactivated) ||
+			errors.Is(err, errConstant.ErrUserDeleted) {
+			code = http.StatusForbidden
+		}
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: code,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code:  http.StatusOK,
+		Data:  user.User,
+		Token: &user.Token,
+		Gin:   ctx,
+	})
+}
+
+func (c *UserController) IssueToken(ctx *gin.Context) {
+	request := &dto.TokenRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := c.userService.GetUser().IssueToken(ctx.Request.Context(), request)
 	if err != nil {
 		response.HTTPResponse(response.ParamHTTPResponse{
 			Code: http.StatusBadRequest,
@@ -95,7 +162,7 @@ func (c *UserController) Register(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.userService.GetUser().Register(ctx, request)
+	user, err := c.userService.GetUser().Register(ctx.Request.Context(), request)
 	if err != nil {
 		// kirim error satu kali
 		response.HTTPResponse(response.ParamHTTPResponse{
@@ -140,16 +207,33 @@ func (c *UserController) Update(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.userService.GetUser().Update(ctx, request, uuid)
+	version, ok := ifMatch(ctx)
+	if !ok {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusPreconditionFailed,
+			Err:  errConstant.ErrVersionConflict,
+			Gin:  ctx,
+		})
+		return
+	}
+	request.Version = version
+
+	user, err := c.userService.GetUser().Update(ctx.Request.Context(), request, uuid)
 	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, errConstant.ErrVersionConflict) {
+			code = http.StatusPreconditionFailed
+		}
 		response.HTTPResponse(response.ParamHTTPResponse{
-			Code: http.StatusBadRequest,
+			Code: code,
 			Err:  err,
 			Gin:  ctx,
 		})
 		return
 	}
 
+	ctx.Header(constants.ETag, etag(user.Version))
+
 	response.HTTPResponse(response.ParamHTTPResponse{
 		Code: http.StatusOK,
 		Data: user,
@@ -186,6 +270,307 @@ func (c *UserController) GetUserByUUID(ctx *gin.Context) {
 		return
 	}
 
+	ctx.Header(constants.ETag, etag(user.Version))
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) GetAll(ctx *gin.Context) {
+	request := &dto.UserListRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	// filter atribut dikirim sebagai attributes[key]=value
+	request.Attributes = ctx.QueryMap("attributes")
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	users, meta, err := c.userService.GetUser().GetAll(ctx.Request.Context(), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: users,
+		Meta: meta,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) ChangeStatus(ctx *gin.Context) {
+	request := &dto.UserStatusRequest{}
+	if err := ctx.ShouldBindJSON(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	user, err := c.userService.GetUser().ChangeStatus(ctx.Request.Context(), ctx.Param("uuid"), request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) GetStatusHistory(ctx *gin.Context) {
+	histories, err := c.userService.GetUser().GetStatusHistory(ctx.Request.Context(), ctx.Param("uuid"))
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: histories,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) UploadAvatar(ctx *gin.Context) {
+	file, err := ctx.FormFile("avatar")
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  errConstant.ErrAvatarRequired,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	user, err := c.userService.GetUser().UploadAvatar(ctx.Request.Context(), file)
+	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, errConstant.ErrAvatarTooLarge) {
+			code = http.StatusRequestEntityTooLarge
+		} else if errors.Is(err, errConstant.ErrAvatarInvalidType) {
+			code = http.StatusUnsupportedMediaType
+		}
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: code,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) DeleteAvatar(ctx *gin.Context) {
+	user, err := c.userService.GetUser().DeleteAvatar(ctx.Request.Context())
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: user,
+		Gin:  ctx,
+	})
+}
+
+func (c *UserController) Export(ctx *gin.Context) {
+	request := &dto.UserExportRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	request.Attributes = ctx.QueryMap("attributes")
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	contentType := "text/csv"
+	if request.Format == constants.ExportFormatJSONL {
+		contentType = "application/x-ndjson"
+	}
+	filename := fmt.Sprintf("users-%s.%s", time.Now().Format("20060102150405"), request.Format)
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Status(http.StatusOK)
+
+	err := c.userService.GetUser().Export(ctx.Request.Context(), ctx.Writer, request)
+	if err != nil {
+		// setelah data mulai terkirim status tidak bisa diubah, stream cukup dihentikan
+		if ctx.Writer.Written() {
+			logrus.Errorf("user export interrupted: %v", err)
+			ctx.Abort()
+			return
+		}
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+	}
+}
+
+func (c *UserController) Patch(ctx *gin.Context) {
+	mediaType, _, _ := mime.ParseMediaType(ctx.GetHeader("Content-Type"))
+	if mediaType != constants.ContentTypeMergePatch && mediaType != "application/json" {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusUnsupportedMediaType,
+			Err:  errConstant.ErrUnsupportedMedia,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	version, ok := ifMatch(ctx)
+	if !ok {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusPreconditionFailed,
+			Err:  errConstant.ErrVersionConflict,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	request, fields, err := parseUserPatch(body)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+	if fields != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    fields,
+			Err:     errConstant.ErrInvalidPatch,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+	request.Version = version
+
+	user, err := c.userService.GetUser().Patch(ctx.Request.Context(), ctx.Param("uuid"), request)
+	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, errConstant.ErrVersionConflict) {
+			code = http.StatusPreconditionFailed
+		}
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: code,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	ctx.Header(constants.ETag, etag(user.Version))
+
 	response.HTTPResponse(response.ParamHTTPResponse{
 		Code: http.StatusOK,
 		Data: user,
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"user-service/common/error"
+	"user-service/common/response"
+	"user-service/constants"
+	"user-service/domain/dto"
+	"user-service/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ImportController struct {
+	service services.IServiceRegistry
+}
+
+type IImportController interface {
+	Import(*gin.Context)
+}
+
+func NewImportController(service services.IServiceRegistry) IImportController {
+	return &ImportController{service: service}
+}
+
+// Import menerima file sebagai body request langsung (text/csv atau application/json)
+// atau sebagai field "file" pada multipart form
+func (c *ImportController) Import(ctx *gin.Context) {
+	request := &dto.ImportRequest{}
+	if err := ctx.ShouldBindQuery(request); err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	var body io.Reader = ctx.Request.Body
+	mediaType, _, _ := mime.ParseMediaType(ctx.GetHeader("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			response.HTTPResponse(response.ParamHTTPResponse{
+				Code: http.StatusBadRequest,
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+		reader, err := file.Open()
+		if err != nil {
+			response.HTTPResponse(response.ParamHTTPResponse{
+				Code: http.StatusBadRequest,
+				Err:  err,
+				Gin:  ctx,
+			})
+			return
+		}
+		defer reader.Close()
+		body = reader
+	}
+	if request.Format == "" {
+		switch mediaType {
+		case "text/csv":
+			request.Format = constants.ImportFormatCSV
+		case "application/json":
+			request.Format = constants.ImportFormatJSON
+		}
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessages := http.StatusText(http.StatusUnprocessableEntity)
+		errResponse := error.ErrValidationResponse(err)
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessages,
+			Data:    errResponse,
+			Err:     err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	report, err := c.service.GetImport().Import(ctx.Request.Context(), body, request)
+	if err != nil {
+		response.HTTPResponse(response.ParamHTTPResponse{
+			Code: http.StatusBadRequest,
+			Err:  err,
+			Gin:  ctx,
+		})
+		return
+	}
+
+	response.HTTPResponse(response.ParamHTTPResponse{
+		Code: http.StatusOK,
+		Data: report,
+		Gin:  ctx,
+	})
+}
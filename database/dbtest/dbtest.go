@@ -0,0 +1,33 @@
+// Package dbtest menyiapkan database SQLite di memori yang sudah dimigrasi untuk test.
+package dbtest
+
+import (
+	"context"
+	"testing"
+	"user-service/config"
+	"user-service/constants"
+	"user-service/database/migrations"
+
+	"gorm.io/gorm"
+)
+
+// Open membuka database SQLite di memori yang baru dan menjalankan seluruh migrasi.
+// Setiap pemanggilan mendapat database terpisah yang ditutup saat test selesai.
+func Open(t testing.TB) *gorm.DB {
+	t.Helper()
+	config.Config.Database = config.Database{Driver: constants.DatabaseSQLite, Name: constants.SQLiteMemory}
+	db, err := config.InitDatabase()
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := migrations.NewMigrationRegistry(db).Up(context.Background()); err != nil {
+		t.Fatalf("migrate database: %v", err)
+	}
+	return db
+}
@@ -0,0 +1,343 @@
+package migrations
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Struct berikut adalah salinan model saat baseline ditulis. Baseline sengaja tidak memakai
+// domain/models agar skema yang dibuatnya tidak ikut berubah ketika model berubah; nama
+// field relasi dipertahankan karena nama foreign key diturunkan darinya.
+
+type baselineRole struct {
+	ID         uint   `gorm:"primaryKey;autoIncrement"`
+	Code       string `gorm:"varchar(15); not null"`
+	Name       string `gorm:"varchar(20); not null"`
+	Privileged bool   `gorm:"not null;default:false"`
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+}
+
+func (baselineRole) TableName() string { return "roles" }
+
+type baselineOrganization struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID `gorm:"type:uuid; not null"`
+	Code      string    `gorm:"type:varchar(30); not null"`
+	Name      string    `gorm:"type:varchar(100); not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+func (baselineOrganization) TableName() string { return "organizations" }
+
+type baselineUser struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID `gorm:"type:uuid; not null"`
+	Name            string    `gorm:"type:varchar(100); not null"`
+	Username        string    `gorm:"type:varchar(20); not null"`
+	Password        string    `gorm:"type:varchar(255); not null"`
+	PhoneNumber     string    `gorm:"type:varchar(15); not null"`
+	Email           string    `gorm:"type:varchar(100); not null"`
+	OrganizationID  *uint
+	EmailVerifiedAt *time.Time
+	Status          string `gorm:"type:varchar(20); not null; default:active"`
+	SuspendedReason string `gorm:"type:varchar(255)"`
+	SuspendedUntil  *time.Time
+	AvatarKey       string         `gorm:"type:varchar(255)"`
+	Attributes      map[string]any `gorm:"type:jsonb; serializer:json"`
+	Version         uint           `gorm:"not null; default:1"`
+	TokensRevokedAt *time.Time
+	LastLoginAt     *time.Time
+	Locale          string `gorm:"type:varchar(10)"`
+	Timezone        string `gorm:"type:varchar(64)"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	DeletedAt       gorm.DeletedAt               `gorm:"index"`
+	Organization    *baselineOrganization        `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	UserRoles       []baselineUserRole           `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Memberships     []baselineOrganizationMember `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (baselineUser) TableName() string { return "users" }
+
+type baselineUserRole struct {
+	UserID    uint `gorm:"primaryKey"`
+	RoleID    uint `gorm:"primaryKey"`
+	StartsAt  *time.Time
+	ExpiresAt *time.Time
+	CreatedAt *time.Time
+	Role      baselineRole `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineUserRole) TableName() string { return "user_roles" }
+
+type baselineOrganizationMember struct {
+	OrganizationID uint `gorm:"primaryKey"`
+	UserID         uint `gorm:"primaryKey"`
+	RoleID         uint `gorm:"primaryKey"`
+	CreatedAt      *time.Time
+	Organization   baselineOrganization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User           baselineUser         `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Role           baselineRole         `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineOrganizationMember) TableName() string { return "organization_members" }
+
+type baselineGroup struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID `gorm:"type:uuid; not null"`
+	OrganizationID *uint
+	Name           string `gorm:"type:varchar(100); not null"`
+	Description    string `gorm:"type:varchar(255)"`
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	Organization   *baselineOrganization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Members        []baselineGroupMember `gorm:"foreignKey:GroupID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineGroup) TableName() string { return "user_groups" }
+
+type baselineGroupMember struct {
+	GroupID   uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"primaryKey"`
+	CreatedAt *time.Time
+	User      baselineUser `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineGroupMember) TableName() string { return "group_members" }
+
+type baselineRoleElevation struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID `gorm:"type:uuid; not null"`
+	UserID          uint      `gorm:"not null"`
+	RoleID          uint      `gorm:"not null"`
+	Reason          string    `gorm:"type:varchar(255); not null"`
+	DurationMinutes int       `gorm:"not null"`
+	Status          string    `gorm:"type:varchar(20); not null"`
+	ReviewedByID    *uint
+	ReviewedAt      *time.Time
+	StartsAt        *time.Time
+	ExpiresAt       *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	User            baselineUser  `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Role            baselineRole  `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ReviewedBy      *baselineUser `gorm:"foreignKey:ReviewedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+func (baselineRoleElevation) TableName() string { return "role_elevations" }
+
+type baselineAuditLog struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID  `gorm:"type:uuid; not null"`
+	ActorType   string     `gorm:"type:varchar(20); not null"`
+	ActorUUID   *uuid.UUID `gorm:"type:uuid; index"`
+	ActorName   string     `gorm:"type:varchar(100)"`
+	Action      string     `gorm:"type:varchar(50); not null"`
+	SubjectType string     `gorm:"type:varchar(30); not null"`
+	SubjectUUID *uuid.UUID `gorm:"type:uuid; index"`
+	Changes     string     `gorm:"type:text"`
+	Metadata    string     `gorm:"type:text"`
+	RequestID   string     `gorm:"type:varchar(64)"`
+	IPAddress   string     `gorm:"type:varchar(45)"`
+	CreatedAt   *time.Time `gorm:"index"`
+}
+
+func (baselineAuditLog) TableName() string { return "audit_logs" }
+
+type baselineAccessReview struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	Name        string    `gorm:"type:varchar(100); not null"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	CreatedByID uint      `gorm:"not null"`
+	ClosedByID  *uint
+	ClosedAt    *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	CreatedBy   baselineUser               `gorm:"foreignKey:CreatedByID;references:ID"`
+	ClosedBy    *baselineUser              `gorm:"foreignKey:ClosedByID;references:ID"`
+	Items       []baselineAccessReviewItem `gorm:"foreignKey:AccessReviewID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineAccessReview) TableName() string { return "access_reviews" }
+
+type baselineAccessReviewItem struct {
+	ID               uint      `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID `gorm:"type:uuid; not null"`
+	AccessReviewID   uint      `gorm:"not null"`
+	UserID           uint      `gorm:"not null"`
+	RoleID           uint      `gorm:"not null"`
+	OrganizationID   *uint
+	Username         string `gorm:"type:varchar(20); not null"`
+	Email            string `gorm:"type:varchar(100); not null"`
+	RoleCode         string `gorm:"type:varchar(15); not null"`
+	OrganizationCode string `gorm:"type:varchar(30)"`
+	Reason           string `gorm:"type:varchar(255)"`
+	GrantedAt        *time.Time
+	ExpiresAt        *time.Time
+	Decision         string `gorm:"type:varchar(20); not null"`
+	Note             string `gorm:"type:varchar(255)"`
+	DecidedByID      *uint
+	DecidedAt        *time.Time
+	User             baselineUser  `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DecidedBy        *baselineUser `gorm:"foreignKey:DecidedByID;references:ID"`
+}
+
+func (baselineAccessReviewItem) TableName() string { return "access_review_items" }
+
+type baselineUserStatusHistory struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	UserID      uint   `gorm:"not null;index"`
+	FromStatus  string `gorm:"type:varchar(20); not null"`
+	ToStatus    string `gorm:"type:varchar(20); not null"`
+	Reason      string `gorm:"type:varchar(255)"`
+	Until       *time.Time
+	ChangedByID *uint
+	CreatedAt   *time.Time
+	ChangedBy   *baselineUser `gorm:"foreignKey:ChangedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+func (baselineUserStatusHistory) TableName() string { return "user_status_histories" }
+
+type baselineErasureRequest struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	UserID      uint      `gorm:"not null;index"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	ScheduledAt time.Time `gorm:"not null"`
+	CancelledAt *time.Time
+	CompletedAt *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	User        baselineUser `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineErasureRequest) TableName() string { return "erasure_requests" }
+
+type baselineAttributeDefinition struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID `gorm:"type:uuid; not null"`
+	Key       string    `gorm:"column:attribute_key; type:varchar(50); not null; uniqueIndex"`
+	Label     string    `gorm:"type:varchar(100); not null"`
+	Type      string    `gorm:"type:varchar(20); not null"`
+	Required  bool      `gorm:"not null; default:false"`
+	Pattern   string    `gorm:"type:varchar(255)"`
+	Min       *float64
+	Max       *float64
+	Options   []string `gorm:"type:text; serializer:json"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+func (baselineAttributeDefinition) TableName() string { return "attribute_definitions" }
+
+type baselineEmailChange struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	UserID      uint      `gorm:"not null; index"`
+	NewEmail    string    `gorm:"type:varchar(100); not null"`
+	TokenHash   string    `gorm:"type:varchar(64); not null; uniqueIndex"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	ConfirmedAt *time.Time
+	CancelledAt *time.Time
+	CreatedAt   *time.Time
+	User        baselineUser `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineEmailChange) TableName() string { return "email_changes" }
+
+type baselineInvitation struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID `gorm:"type:uuid; not null"`
+	Email          string    `gorm:"type:varchar(100); not null; index"`
+	Name           string    `gorm:"type:varchar(100); not null"`
+	RoleID         uint      `gorm:"not null"`
+	OrganizationID *uint
+	TokenHash      string    `gorm:"type:varchar(64); not null; uniqueIndex"`
+	Status         string    `gorm:"type:varchar(20); not null"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	InvitedByID    *uint
+	UserID         *uint
+	AcceptedAt     *time.Time
+	RevokedAt      *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	Role           baselineRole          `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Organization   *baselineOrganization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	InvitedBy      *baselineUser         `gorm:"foreignKey:InvitedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	User           *baselineUser         `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+func (baselineInvitation) TableName() string { return "invitations" }
+
+type baselineLoginAttempt struct {
+	ID            uint          `gorm:"primaryKey;autoIncrement"`
+	UUID          uuid.UUID     `gorm:"type:uuid; not null"`
+	UserID        *uint         `gorm:"index"`
+	Username      string        `gorm:"type:varchar(100); not null"`
+	Method        string        `gorm:"type:varchar(20); not null"`
+	Success       bool          `gorm:"not null"`
+	FailureReason string        `gorm:"type:varchar(30)"`
+	IPAddress     string        `gorm:"type:varchar(45)"`
+	UserAgent     string        `gorm:"type:varchar(255)"`
+	Device        string        `gorm:"type:varchar(100)"`
+	CreatedAt     *time.Time    `gorm:"index"`
+	User          *baselineUser `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (baselineLoginAttempt) TableName() string { return "login_attempts" }
+
+// baselineModels adalah tabel yang sebelumnya dibuat lewat AutoMigrate saat serve,
+// diurutkan agar tabel yang dirujuk foreign key dibuat lebih dulu
+var baselineModels = []any{
+	&baselineRole{},
+	&baselineOrganization{},
+	&baselineUser{},
+	&baselineUserRole{},
+	&baselineOrganizationMember{},
+	&baselineGroup{},
+	&baselineGroupMember{},
+	&baselineRoleElevation{},
+	&baselineAuditLog{},
+	&baselineAccessReview{},
+	&baselineAccessReviewItem{},
+	&baselineUserStatusHistory{},
+	&baselineErasureRequest{},
+	&baselineAttributeDefinition{},
+	&baselineEmailChange{},
+	&baselineInvitation{},
+	&baselineLoginAttempt{},
+}
+
+// Baseline mengambil alih skema yang dulu dibuat AutoMigrate. Pada database lama migrasi
+// ini hanya melengkapi kolom yang belum ada, sehingga aman dijalankan di atas skema yang
+// sudah terisi. Perubahan skema berikutnya wajib ditulis sebagai migrasi baru.
+func init() {
+	register(Migration{
+		Version: 20261019000000,
+		Name:    "baseline",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(baselineModels...); err != nil {
+				return err
+			}
+			return migrateUserRoles(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			tables := slices.Clone(baselineModels)
+			slices.Reverse(tables)
+			// dihapus satu per satu agar urutannya tidak diatur ulang oleh migrator dialect
+			for _, table := range tables {
+				if err := tx.Migrator().DropTable(table); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+	"user-service/constants"
+
+	"gorm.io/gorm"
+)
+
+// uniqueUserIndexes adalah unique index pada users. Username dan email dibandingkan tanpa
+// membedakan huruf besar kecil dan unik per tenant, termasuk user yang sudah dihapus;
+// organization_id NULL (user tanpa tenant) dipetakan ke 0 karena NULL tidak pernah
+// dianggap sama oleh unique index. UUID tetap unik lintas tenant.
+var uniqueUserIndexes = []struct {
+	name   string
+	tenant bool
+	value  string
+}{
+	{constants.UsersUUIDUniqueIndex, false, "uuid"},
+	{constants.UsersUsernameUniqueIndex, true, "lower(username)"},
+	{constants.UsersEmailUniqueIndex, true, "lower(email)"},
+}
+
+// tenantExpression adalah bagian tenant pada unique index per tenant
+const tenantExpression = "COALESCE(organization_id, 0)"
+
+func init() {
+	register(Migration{
+		Version: 20261019080000,
+		Name:    "unique_user_identity",
+		Up: func(tx *gorm.DB) error {
+			for _, index := range uniqueUserIndexes {
+				tenant := "0"
+				parts := []string{index.value}
+				if index.tenant {
+					tenant = tenantExpression
+					parts = []string{tenantExpression, index.value}
+				}
+				if err := checkDuplicates(tx, tenant, index.value); err != nil {
+					return err
+				}
+				if tx.Migrator().HasIndex("users", index.name) {
+					continue
+				}
+				err := tx.Exec(fmt.Sprintf("CREATE UNIQUE INDEX %s ON users (%s)",
+					index.name, indexExpression(tx, parts))).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			for _, index := range uniqueUserIndexes {
+				if !tx.Migrator().HasIndex("users", index.name) {
+					continue
+				}
+				if err := tx.Migrator().DropIndex("users", index.name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// indexExpression menyusun daftar kolom index sesuai dialect. MySQL mewajibkan setiap
+// ekspresi fungsi pada index berada di dalam tanda kurung tambahan.
+func indexExpression(tx *gorm.DB, parts []string) string {
+	if tx.Dialector.Name() == constants.DatabaseMySQL {
+		wrapped := make([]string, 0, len(parts))
+		for _, part := range parts {
+			if strings.Contains(part, "(") {
+				part = "(" + part + ")"
+			}
+			wrapped = append(wrapped, part)
+		}
+		parts = wrapped
+	}
+	return strings.Join(parts, ", ")
+}
+
+// checkDuplicates menggagalkan migrasi dengan daftar nilai ganda per tenant agar data bisa
+// dibereskan lebih dulu, alih-alih error unique violation yang tidak menyebut nilainya
+func checkDuplicates(tx *gorm.DB, tenant, value string) error {
+	textType := "VARCHAR"
+	if tx.Dialector.Name() == constants.DatabaseMySQL {
+		textType = "CHAR"
+	}
+
+	var duplicates []struct {
+		TenantID       uint
+		DuplicateValue string
+	}
+	err := tx.Raw(fmt.Sprintf(
+		"SELECT %s AS tenant_id, CAST(%s AS %s) AS duplicate_value FROM users GROUP BY 1, 2 HAVING COUNT(*) > 1 ORDER BY 1, 2 LIMIT 10",
+		tenant, value, textType,
+	)).Scan(&duplicates).Error
+	if err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		if duplicate.TenantID == 0 {
+			values = append(values, duplicate.DuplicateValue)
+			continue
+		}
+		values = append(values, fmt.Sprintf("%s (organization_id %d)", duplicate.DuplicateValue, duplicate.TenantID))
+	}
+	return fmt.Errorf("users has duplicate %s values, resolve them before migrating: %s",
+		value, strings.Join(values, ", "))
+}
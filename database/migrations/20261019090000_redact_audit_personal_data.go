@@ -0,0 +1,112 @@
+package migrations
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// daftar ini sengaja disalin dan tidak memakai constants agar arti migrasi tidak berubah
+// ketika daftar field yang disamarkan berubah di kemudian hari
+var (
+	auditPersonalFields   = []string{"password", "name", "username", "email", "phoneNumber"}
+	auditAttributePrefix  = "attributes."
+	auditRedactedValue    = "[REDACTED]"
+	auditRedactBatchSize  = 500
+	auditInvitationAction = "invitation.created"
+)
+
+type auditLogRow struct {
+	ID        uint
+	Action    string
+	ActorType string
+	ActorName string
+	Changes   string
+	Metadata  string
+}
+
+// Audit log lama menyimpan data pribadi apa adanya (diff profil, username pelaku, email
+// undangan), sehingga tidak ikut hilang saat erasure. Migrasi ini menyamarkannya. Update
+// memakai nama tabel langsung karena hook model AuditLog menolak setiap perubahan.
+func init() {
+	register(Migration{
+		Version: 20261019090000,
+		Name:    "redact_audit_personal_data",
+		Up: func(tx *gorm.DB) error {
+			var rows []auditLogRow
+			return tx.Table("audit_logs").
+				Select("id", "action", "actor_type", "actor_name", "changes", "metadata").
+				FindInBatches(&rows, auditRedactBatchSize, func(batch *gorm.DB, _ int) error {
+					for _, row := range rows {
+						updates, err := redactAuditLog(row)
+						if err != nil {
+							return err
+						}
+						if len(updates) == 0 {
+							continue
+						}
+						if err := tx.Table("audit_logs").Where("id = ?", row.ID).Updates(updates).Error; err != nil {
+							return err
+						}
+					}
+					return nil
+				}).Error
+		},
+		// nilai yang sudah disamarkan tidak bisa dikembalikan
+		Down: func(tx *gorm.DB) error {
+			return nil
+		},
+	})
+}
+
+// redactAuditLog mengembalikan kolom yang perlu diubah pada satu entri audit
+func redactAuditLog(row auditLogRow) (map[string]any, error) {
+	updates := map[string]any{}
+	if row.ActorType == "user" && row.ActorName != "" {
+		updates["actor_name"] = ""
+	}
+
+	if row.Changes != "" {
+		changes := map[string]map[string]any{}
+		if err := json.Unmarshal([]byte(row.Changes), &changes); err != nil {
+			return nil, err
+		}
+		redacted := false
+		for key, change := range changes {
+			if !slices.Contains(auditPersonalFields, key) && !strings.HasPrefix(key, auditAttributePrefix) {
+				continue
+			}
+			for side, value := range change {
+				if value != nil && value != "" && value != auditRedactedValue {
+					change[side] = auditRedactedValue
+					redacted = true
+				}
+			}
+		}
+		if redacted {
+			data, err := json.Marshal(changes)
+			if err != nil {
+				return nil, err
+			}
+			updates["changes"] = string(data)
+		}
+	}
+
+	if row.Action == auditInvitationAction && row.Metadata != "" {
+		metadata := map[string]any{}
+		if err := json.Unmarshal([]byte(row.Metadata), &metadata); err != nil {
+			return nil, err
+		}
+		if _, ok := metadata["email"]; ok {
+			delete(metadata, "email")
+			data, err := json.Marshal(metadata)
+			if err != nil {
+				return nil, err
+			}
+			updates["metadata"] = string(data)
+		}
+	}
+	return updates, nil
+}
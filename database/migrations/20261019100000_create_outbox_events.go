@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// outboxEventTable adalah bentuk tabel outbox_events saat migrasi ini ditulis; sengaja
+// tidak memakai models.OutboxEvent agar migrasi tidak berubah mengikuti model
+type outboxEventTable struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	EventID       uuid.UUID `gorm:"type:uuid; not null; uniqueIndex"`
+	Type          string    `gorm:"type:varchar(100); not null"`
+	Payload       string    `gorm:"type:text; not null"`
+	Attempts      int       `gorm:"not null; default:0"`
+	NextAttemptAt time.Time `gorm:"not null; index"`
+	DeliveredAt   *time.Time
+	LastError     string `gorm:"type:text"`
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+func (outboxEventTable) TableName() string {
+	return "outbox_events"
+}
+
+// Event untuk service lain disimpan ke outbox dalam transaksi perubahan datanya lalu
+// dikirim oleh job, sehingga event tidak hilang ketika publisher sedang gagal.
+func init() {
+	register(Migration{
+		Version: 20261019100000,
+		Name:    "create_outbox_events",
+		Up: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&outboxEventTable{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&outboxEventTable{})
+		},
+	})
+}
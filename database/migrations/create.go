@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+const template = `package migrations
+
+import "gorm.io/gorm"
+
+func init() {
+	register(Migration{
+		Version: %d,
+		Name:    %q,
+		Up: func(tx *gorm.DB) error {
+			return nil
+		},
+		Down: func(tx *gorm.DB) error {
+			return nil
+		},
+	})
+}
+`
+
+// Create menulis file migrasi baru berisi Up dan Down kosong ke dir dan mengembalikan
+// path file tersebut. Nama diubah menjadi snake_case.
+func Create(dir, name string) (string, error) {
+	name = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", fmt.Errorf("migration name must contain letters or digits")
+	}
+	// format timestamp selalu berupa angka sehingga parse tidak mungkin gagal
+	version, _ := strconv.ParseInt(time.Now().UTC().Format("20060102150405"), 10, 64)
+	path := filepath.Join(dir, fmt.Sprintf("%d_%s.go", version, name))
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err = fmt.Fprintf(file, template, version, name); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,238 @@
+package migrations
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"user-service/constants"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// lockKey adalah kunci lock database yang menjaga agar hanya satu proses yang
+// menjalankan migrasi pada satu waktu, misalnya saat beberapa replika start bersamaan.
+// Postgres memakai advisory lock berkunci angka, MySQL memakai named lock.
+const (
+	lockKey     int64 = 7_261_019_047
+	lockKeyName       = "user-service.migrations"
+)
+
+// Migration adalah satu perubahan skema atau data berversi. Version berupa timestamp
+// yyyymmddhhmmss sehingga urutan migrasi mengikuti waktu pembuatannya.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(*gorm.DB) error
+	Down    func(*gorm.DB) error
+}
+
+// SchemaMigration mencatat migrasi yang sudah dijalankan pada database
+type SchemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255); not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus adalah status satu migrasi. Missing berarti migrasi tercatat di
+// database namun file-nya tidak ada lagi di kode.
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+	Missing   bool
+}
+
+var registered []Migration
+
+// register mendaftarkan migrasi; dipanggil dari init() pada setiap file migrasi
+func register(migration Migration) {
+	if slices.ContainsFunc(registered, func(item Migration) bool { return item.Version == migration.Version }) {
+		panic(fmt.Sprintf("duplicate migration version %d", migration.Version))
+	}
+	registered = append(registered, migration)
+	slices.SortFunc(registered, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+}
+
+type Registry struct {
+	db *gorm.DB
+}
+
+type IMigrationRegistry interface {
+	Up(context.Context) error
+	Down(context.Context, int) error
+	Status(context.Context) ([]MigrationStatus, error)
+}
+
+func NewMigrationRegistry(db *gorm.DB) IMigrationRegistry {
+	return &Registry{db: db}
+}
+
+// lock mengambil lock migrasi pada satu koneksi khusus dan mengembalikan fungsi untuk
+// melepasnya. Proses lain yang memanggil lock akan menunggu sampai lock dilepas.
+// SQLite tidak memiliki lock seperti ini; database SQLite dianggap hanya dipakai satu proses.
+func (r *Registry) lock(ctx context.Context) (func(), error) {
+	var lockQuery, unlockQuery string
+	var key any
+	switch r.db.Dialector.Name() {
+	case constants.DatabasePostgres:
+		lockQuery, unlockQuery, key = "SELECT pg_advisory_lock($1)", "SELECT pg_advisory_unlock($1)", lockKey
+	case constants.DatabaseMySQL:
+		lockQuery, unlockQuery, key = "SELECT GET_LOCK(?, -1)", "SELECT RELEASE_LOCK(?)", lockKeyName
+	default:
+		return func() {}, nil
+	}
+
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = conn.ExecContext(ctx, lockQuery, key); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), unlockQuery, key); err != nil {
+			logrus.Errorf("failed to release migration lock: %v", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+// applied membaca migrasi yang sudah dijalankan, diurutkan dari versi terlama
+func (r *Registry) applied(ctx context.Context) ([]SchemaMigration, error) {
+	if err := r.db.WithContext(ctx).AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, err
+	}
+	var migrations []SchemaMigration
+	err := r.db.WithContext(ctx).Order("version").Find(&migrations).Error
+	return migrations, err
+}
+
+// Up menjalankan seluruh migrasi yang belum dijalankan secara berurutan. Setiap migrasi
+// berjalan dalam transaksinya sendiri bersama pencatatannya di schema_migrations.
+// Perubahan DDL di MySQL tidak ikut transaksi, sehingga migrasi yang gagal di tengah
+// jalan bisa meninggalkan sebagian perubahan skema.
+func (r *Registry) Up(ctx context.Context) error {
+	unlock, err := r.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return err
+	}
+	done := map[int64]bool{}
+	for _, migration := range applied {
+		done[migration.Version] = true
+	}
+
+	for _, migration := range registered {
+		if done[migration.Version] {
+			continue
+		}
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		logrus.Infof("migration %d_%s applied", migration.Version, migration.Name)
+	}
+	return nil
+}
+
+// Down membatalkan steps migrasi terakhir yang sudah dijalankan, dari versi terbaru
+func (r *Registry) Down(ctx context.Context, steps int) error {
+	unlock, err := r.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return err
+	}
+	slices.Reverse(applied)
+	if steps < len(applied) {
+		applied = applied[:steps]
+	}
+
+	for _, record := range applied {
+		index := slices.IndexFunc(registered, func(item Migration) bool { return item.Version == record.Version })
+		if index < 0 {
+			return fmt.Errorf("migration %d_%s is not known by this build", record.Version, record.Name)
+		}
+		migration := registered[index]
+		if migration.Down == nil {
+			return fmt.Errorf("migration %d_%s cannot be rolled back", migration.Version, migration.Name)
+		}
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return fmt.Errorf("rollback of migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		logrus.Infof("migration %d_%s rolled back", migration.Version, migration.Name)
+	}
+	return nil
+}
+
+// Status menggabungkan migrasi yang terdaftar di kode dengan yang tercatat di database
+func (r *Registry) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := r.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := map[int64]SchemaMigration{}
+	for _, migration := range applied {
+		records[migration.Version] = migration
+	}
+
+	statuses := []MigrationStatus{}
+	for _, migration := range registered {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if record, ok := records[migration.Version]; ok {
+			status.AppliedAt = &record.AppliedAt
+			delete(records, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range records {
+		statuses = append(statuses, MigrationStatus{
+			Version:   record.Version,
+			Name:      record.Name,
+			AppliedAt: &record.AppliedAt,
+			Missing:   true,
+		})
+	}
+	slices.SortFunc(statuses, func(a, b MigrationStatus) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return statuses, nil
+}
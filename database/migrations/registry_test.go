@@ -0,0 +1,130 @@
+package migrations
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"user-service/config"
+	"user-service/constants"
+
+	"gorm.io/gorm"
+)
+
+func openDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+	config.Config.Database = config.Database{Driver: constants.DatabaseSQLite, Name: constants.SQLiteMemory}
+	db, err := config.InitDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := db.DB()
+	t.Cleanup(func() { sqlDB.Close() })
+	return db
+}
+
+func TestUpAndDown(t *testing.T) {
+	db := openDatabase(t)
+	registry := NewMigrationRegistry(db)
+	ctx := context.Background()
+
+	if err := registry.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	// migrasi yang sudah dijalankan tidak dijalankan ulang
+	if err := registry.Up(ctx); err != nil {
+		t.Fatalf("second Up: %v", err)
+	}
+	statuses, err := registry.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != len(registered) {
+		t.Fatalf("Status returned %d migrations, want %d", len(statuses), len(registered))
+	}
+	for _, status := range statuses {
+		if status.AppliedAt == nil || status.Missing {
+			t.Errorf("migration %d_%s is not applied", status.Version, status.Name)
+		}
+	}
+	for _, table := range []string{"users", "user_roles", "audit_logs", "login_attempts", "outbox_events"} {
+		if !db.Migrator().HasTable(table) {
+			t.Errorf("table %s is missing after Up", table)
+		}
+	}
+	if !db.Migrator().HasIndex("users", constants.UsersEmailUniqueIndex) {
+		t.Errorf("index %s is missing after Up", constants.UsersEmailUniqueIndex)
+	}
+
+	if err := registry.Down(ctx, 1); err != nil {
+		t.Fatalf("Down 1: %v", err)
+	}
+	if db.Migrator().HasTable("outbox_events") {
+		t.Error("outbox_events still exists after rolling back its migration")
+	}
+	if !db.Migrator().HasTable("users") {
+		t.Error("Down 1 rolled back more than one migration")
+	}
+
+	if err := registry.Down(ctx, len(registered)); err != nil {
+		t.Fatalf("Down all: %v", err)
+	}
+	for _, table := range []string{"users", "roles", "audit_logs", "login_attempts"} {
+		if db.Migrator().HasTable(table) {
+			t.Errorf("table %s still exists after rolling back every migration", table)
+		}
+	}
+
+	if err := registry.Up(ctx); err != nil {
+		t.Fatalf("Up after Down: %v", err)
+	}
+}
+
+// unique index username dan email dibuat per tenant, sehingga nilai yang sama pada tenant
+// berbeda tidak menggagalkan migrasi, sedangkan nilai ganda pada satu tenant disebutkan
+func TestUniqueUserIdentityChecksDuplicatesPerTenant(t *testing.T) {
+	db := openDatabase(t)
+	registry := NewMigrationRegistry(db)
+	ctx := context.Background()
+	if err := registry.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// kembali ke sebelum 20261019080000_unique_user_identity
+	steps := 0
+	for _, migration := range registered {
+		if migration.Version >= 20261019080000 {
+			steps++
+		}
+	}
+	if err := registry.Down(ctx, steps); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.Exec(`INSERT INTO organizations (id, uuid, code, name)
+		VALUES (1, 'org-1', 'acme', 'Acme'), (2, 'org-2', 'globex', 'Globex')`).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	insert := func(organizationID any, username, email string) {
+		t.Helper()
+		err := db.Exec(`INSERT INTO users (uuid, name, username, password, phone_number, email, organization_id)
+			VALUES (lower(hex(randomblob(16))), 'x', ?, 'x', '0', ?, ?)`, username, email, organizationID).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	insert(1, "alice", "alice@example.com")
+	insert(2, "Alice", "ALICE@example.com")
+	insert(nil, "alice", "alice@example.com")
+	if err := registry.Up(ctx); err != nil {
+		t.Fatalf("Up with duplicates across tenants: %v", err)
+	}
+
+	if err := registry.Down(ctx, steps); err != nil {
+		t.Fatal(err)
+	}
+	insert(2, "ALICE", "alice2@example.com")
+	err = registry.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "alice (organization_id 2)") {
+		t.Fatalf("Up with duplicates in one tenant = %v, want the duplicate listed", err)
+	}
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// migrateUserRoles memindahkan kolom users.role_id lama ke tabel user_roles
+// lalu menghapus kolom tersebut. Aman dijalankan berulang kali.
+func migrateUserRoles(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn("users", "role_id") {
+		return nil
+	}
+
+	err := tx.Exec(`INSERT INTO user_roles (user_id, role_id, created_at)
+		SELECT u.id, u.role_id, CURRENT_TIMESTAMP FROM users u
+		WHERE u.role_id IS NOT NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM user_roles ur WHERE ur.user_id = u.id AND ur.role_id = u.role_id
+		)`).Error
+	if err != nil {
+		return err
+	}
+	return tx.Migrator().DropColumn("users", "role_id")
+}
@@ -0,0 +1,68 @@
+package seeders
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+
+	"go.yaml.in/yaml/v3"
+)
+
+//go:embed fixtures
+var embedded embed.FS
+
+var fixtureExtensions = []string{".yaml", ".yml", ".json"}
+
+// Fixtures membaca data seed dari file YAML atau JSON untuk satu environment
+type Fixtures struct {
+	files       fs.FS
+	environment string
+}
+
+// NewFixtures memakai fixture di dir, atau fixture bawaan yang ikut di-embed ke binary
+// jika dir kosong
+func NewFixtures(dir, environment string) (*Fixtures, error) {
+	if dir == "" {
+		files, err := fs.Sub(embedded, "fixtures")
+		if err != nil {
+			return nil, err
+		}
+		return &Fixtures{files: files, environment: environment}, nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	return &Fixtures{files: os.DirFS(dir), environment: environment}, nil
+}
+
+// Load membaca fixture name ke dest. File di folder environment (<env>/<name>.yaml)
+// didahulukan dari file bersama (<name>.yaml). Mengembalikan false jika tidak ada file.
+func (f *Fixtures) Load(name string, dest any) (bool, error) {
+	for _, dir := range []string{f.environment, "."} {
+		for _, extension := range fixtureExtensions {
+			file := path.Join(dir, name+extension)
+			content, err := fs.ReadFile(f.files, file)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+
+			if extension == ".json" {
+				err = json.Unmarshal(content, dest)
+			} else {
+				err = yaml.Unmarshal(content, dest)
+			}
+			if err != nil {
+				return false, fmt.Errorf("fixture %s: %w", file, err)
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,34 @@
+package seeders
+
+import (
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type organizationFixture struct {
+	Code string `json:"code" yaml:"code"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// seedOrganizations membuat organisasi dari fixture organizations; organisasi dengan
+// kode yang sudah ada dilewati
+func seedOrganizations(tx *gorm.DB, fixtures *Fixtures) error {
+	var organizations []organizationFixture
+	found, err := fixtures.Load("organizations", &organizations)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, fixture := range organizations {
+		organization := models.Organization{UUID: uuid.New(), Code: fixture.Code, Name: fixture.Name}
+		err := tx.Where(models.Organization{Code: fixture.Code}).FirstOrCreate(&organization).Error
+		if err != nil {
+			return err
+		}
+		logrus.Infof("organization %s successfully seeded", organization.Code)
+	}
+	return nil
+}
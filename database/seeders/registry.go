@@ -1,20 +1,78 @@
 package seeders
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"fmt"
+	"slices"
+	"user-service/constants"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// seeder mengisi satu jenis data. Setiap seeder harus idempotent: data yang sudah ada
+// dilewati sehingga seed aman dijalankan berulang kali.
+type seeder func(tx *gorm.DB, fixtures *Fixtures) error
+
+// Seeders berisi nama seeder sesuai urutan jalannya; seeder yang dirujuk seeder lain
+// (misalnya roles untuk users) harus berada lebih dulu
+var Seeders = []string{"roles", "admin", "organizations", "users"}
+
+var seeders = map[string]seeder{
+	"roles":         seedRoles,
+	"admin":         seedAdmin,
+	"organizations": seedOrganizations,
+	"users":         seedUsers,
+}
+
+// Profiles adalah seeder yang dijalankan per environment. Production hanya berisi data
+// wajib, data contoh hanya untuk staging dan development.
+var Profiles = map[string][]string{
+	constants.EnvProduction:  {"roles", "admin"},
+	constants.EnvStaging:     {"roles", "admin", "organizations", "users"},
+	constants.EnvDevelopment: {"roles", "admin", "organizations", "users"},
+}
 
 type Registry struct {
-	db *gorm.DB
+	db       *gorm.DB
+	fixtures *Fixtures
 }
 
 type ISeederRegistry interface {
-	Run()
+	Run(context.Context, ...string) error
 }
 
-func NewSeederRegistry(db *gorm.DB) ISeederRegistry {
-	return &Registry{db: db}
+func NewSeederRegistry(db *gorm.DB, fixtures *Fixtures) ISeederRegistry {
+	return &Registry{db: db, fixtures: fixtures}
 }
 
-func (s *Registry) Run() {
-	RunRoleSeeder(s.db)
-	RunUserSeeder(s.db)
+// Run menjalankan seeder dengan nama names, atau seluruh seeder pada profile environment
+// fixture jika names kosong. Setiap seeder berjalan dalam transaksinya sendiri.
+func (s *Registry) Run(ctx context.Context, names ...string) error {
+	if len(names) == 0 {
+		profile, ok := Profiles[s.fixtures.environment]
+		if !ok {
+			return fmt.Errorf("unknown environment %q", s.fixtures.environment)
+		}
+		names = profile
+	}
+	for _, name := range names {
+		if _, ok := seeders[name]; !ok {
+			return fmt.Errorf("unknown seeder %q", name)
+		}
+	}
+
+	for _, name := range Seeders {
+		if !slices.Contains(names, name) {
+			continue
+		}
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return seeders[name](tx, s.fixtures)
+		})
+		if err != nil {
+			return fmt.Errorf("seeder %s failed: %w", name, err)
+		}
+		logrus.Infof("seeder %s done", name)
+	}
+	return nil
 }
@@ -1,23 +1,54 @@
 package seeders
 
 import (
+	"fmt"
 	"user-service/domain/models"
 
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
-func RunRoleSeeder(db *gorm.DB) {
-	roles := []models.Role{
-		{Code: "ADMIN", Name: "Administrator"},
-		{Code: "CUSTOMER", Name: "Customer"},
+type roleFixture struct {
+	Code       string `json:"code" yaml:"code"`
+	Name       string `json:"name" yaml:"name"`
+	Privileged bool   `json:"privileged" yaml:"privileged"`
+}
+
+// seedRoles membuat role dari fixture roles berdasarkan kode. Role yang sudah ada hanya
+// diperbarui flag privileged-nya.
+func seedRoles(tx *gorm.DB, fixtures *Fixtures) error {
+	var roles []roleFixture
+	found, err := fixtures.Load("roles", &roles)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("fixture roles not found")
 	}
-	for _, role := range roles {
-		err := db.FirstOrCreate(&role, models.Role{Code: role.Code}).Error
+
+	for _, fixture := range roles {
+		role := models.Role{Code: fixture.Code, Name: fixture.Name, Privileged: fixture.Privileged}
+		err := tx.Where(models.Role{Code: role.Code}).
+			Assign(map[string]any{"privileged": role.Privileged}).
+			FirstOrCreate(&role).Error
+		if err != nil {
+			return err
+		}
+		logrus.Infof("role %s successfully seeded", role.Code)
+	}
+	return nil
+}
+
+// findRoleIDs mencari ID role berdasarkan kode; semua kode harus sudah di-seed
+func findRoleIDs(tx *gorm.DB, codes []string) ([]uint, error) {
+	roleIDs := make([]uint, 0, len(codes))
+	for _, code := range codes {
+		var role models.Role
+		err := tx.Where("code = ?", code).First(&role).Error
 		if err != nil {
-			logrus.Errorf("Failed to seed role: %v", err)
-			panic(err)
+			return nil, fmt.Errorf("role %s: %w", code, err)
 		}
-		logrus.Infof("role %s successfully seeder", role.Code)
+		roleIDs = append(roleIDs, role.ID)
 	}
+	return roleIDs, nil
 }
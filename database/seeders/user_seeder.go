@@ -1,6 +1,10 @@
 package seeders
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"user-service/config"
 	"user-service/constants"
 	"user-service/domain/models"
 
@@ -10,22 +14,147 @@ import (
 	"gorm.io/gorm"
 )
 
-func RunUserSeeder(db *gorm.DB) {
-	password, _ := bcrypt.GenerateFromPassword([]byte("admin123"), bcrypt.DefaultCost)
-	users := models.User{
+type userFixture struct {
+	Username     string   `json:"username" yaml:"username"`
+	Name         string   `json:"name" yaml:"name"`
+	Email        string   `json:"email" yaml:"email"`
+	PhoneNumber  string   `json:"phoneNumber" yaml:"phoneNumber"`
+	Password     string   `json:"password" yaml:"password"`
+	Organization string   `json:"organization" yaml:"organization"`
+	Roles        []string `json:"roles" yaml:"roles"`
+}
+
+// seedAdmin membuat administrator dari config seed.admin. Jika password tidak diatur,
+// password dibuat acak dan hanya ditampilkan sekali saat admin dibuat.
+func seedAdmin(tx *gorm.DB, _ *Fixtures) error {
+	admin := config.Config.Seed.Admin
+	fixture := userFixture{
+		Username:    admin.Username,
+		Name:        admin.Name,
+		Email:       admin.Email,
+		PhoneNumber: admin.PhoneNumber,
+		Password:    admin.Password,
+		Roles:       []string{"ADMIN"},
+	}
+	if fixture.Username == "" {
+		fixture.Username = constants.DefaultSeedAdminUsername
+	}
+	if fixture.Name == "" {
+		fixture.Name = constants.DefaultSeedAdminName
+	}
+	if fixture.Email == "" {
+		return fmt.Errorf("seed.admin.email is required")
+	}
+
+	generated := fixture.Password == ""
+	if generated {
+		password, err := generatePassword()
+		if err != nil {
+			return err
+		}
+		fixture.Password = password
+	}
+
+	created, err := createUser(tx, fixture)
+	if err != nil || !created {
+		return err
+	}
+	if generated {
+		fmt.Printf("Generated password for admin %s: %s\n", fixture.Username, fixture.Password)
+		fmt.Println("Store it now, it will not be shown again.")
+	}
+	return nil
+}
+
+// seedUsers membuat user contoh dari fixture users. User tanpa password mendapat password
+// acak yang tidak ditampilkan, sehingga tidak bisa login sampai passwordnya diganti admin.
+func seedUsers(tx *gorm.DB, fixtures *Fixtures) error {
+	var users []userFixture
+	found, err := fixtures.Load("users", &users)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, fixture := range users {
+		if fixture.Password == "" {
+			fixture.Password, err = generatePassword()
+			if err != nil {
+				return err
+			}
+		}
+		if _, err := createUser(tx, fixture); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createUser membuat user dari fixture. User dengan username atau email yang sudah
+// terpakai, termasuk yang sudah dihapus, dilewati dan mengembalikan false.
+func createUser(tx *gorm.DB, fixture userFixture) (bool, error) {
+	var count int64
+	err := tx.Unscoped().Model(&models.User{}).
+		Where("lower(username) = lower(?) OR lower(email) = lower(?)", fixture.Username, fixture.Email).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		logrus.Infof("user %s already exists, skipped", fixture.Username)
+		return false, nil
+	}
+
+	roleIDs, err := findRoleIDs(tx, fixture.Roles)
+	if err != nil {
+		return false, err
+	}
+	password, err := bcrypt.GenerateFromPassword([]byte(fixture.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return false, err
+	}
+
+	user := models.User{
 		UUID:        uuid.New(),
-		Name:        "Administrator",
-		Username:    "admin",
+		Name:        fixture.Name,
+		Username:    fixture.Username,
 		Password:    string(password),
-		PhoneNumber: "081234567890",
-		Email:       "admin@gmail.com",
-		RoleID:      constants.Admin,
+		PhoneNumber: fixture.PhoneNumber,
+		Email:       fixture.Email,
+		Status:      constants.UserActive,
+		Version:     1,
 	}
 
-	err := db.FirstOrCreate(&users, models.User{Username: users.Username}).Error
-	if err != nil {
-		logrus.Errorf("Failed to seed user: %v", err)
-		panic(err)
+	// user tenant mendapat role sebagai anggota organisasi, sama seperti saat register
+	if fixture.Organization != "" {
+		var organization models.Organization
+		err := tx.Where("code = ?", fixture.Organization).First(&organization).Error
+		if err != nil {
+			return false, fmt.Errorf("organization %s: %w", fixture.Organization, err)
+		}
+		user.OrganizationID = &organization.ID
+		for _, roleID := range roleIDs {
+			user.Memberships = append(user.Memberships, models.OrganizationMember{
+				OrganizationID: organization.ID,
+				RoleID:         roleID,
+			})
+		}
+	} else {
+		for _, roleID := range roleIDs {
+			user.UserRoles = append(user.UserRoles, models.UserRole{RoleID: roleID})
+		}
+	}
+
+	if err := tx.Create(&user).Error; err != nil {
+		return false, err
+	}
+	logrus.Infof("user %s successfully seeded", user.Username)
+	return true, nil
+}
+
+func generatePassword() (string, error) {
+	buf := make([]byte, 18)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
 	}
-	logrus.Infof("user %s successfully seeder", users.Username)
+	return base64.RawURLEncoding.EncodeToString(buf), nil
 }
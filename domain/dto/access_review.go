@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessReviewRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type AccessReviewDecisionRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=keep revoke"`
+	Note     string `json:"note" validate:"max=255"`
+}
+
+type AccessReviewExportRequest struct {
+	Format string `form:"format"`
+}
+
+type AccessReviewItemResponse struct {
+	UUID         uuid.UUID  `json:"uuid"`
+	UserUUID     uuid.UUID  `json:"userUUID"`
+	Username     string     `json:"username"`
+	Email        string     `json:"email"`
+	Role         string     `json:"role"`
+	Organization string     `json:"organization,omitempty"`
+	Reason       string     `json:"reason"`
+	GrantedAt    *time.Time `json:"grantedAt"`
+	ExpiresAt    *time.Time `json:"expiresAt"`
+	Decision     string     `json:"decision"`
+	Note         string     `json:"note"`
+	DecidedBy    *uuid.UUID `json:"decidedBy"`
+	DecidedAt    *time.Time `json:"decidedAt"`
+}
+
+type AccessReviewResponse struct {
+	UUID      uuid.UUID                  `json:"uuid"`
+	Name      string                     `json:"name"`
+	Status    string                     `json:"status"`
+	CreatedBy uuid.UUID                  `json:"createdBy"`
+	ClosedBy  *uuid.UUID                 `json:"closedBy"`
+	ClosedAt  *time.Time                 `json:"closedAt"`
+	CreatedAt *time.Time                 `json:"createdAt"`
+	Items     []AccessReviewItemResponse `json:"items,omitempty"`
+}
+
+type ExportFile struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
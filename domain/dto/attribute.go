@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttributeDefinitionRequest struct {
+	Key      string   `json:"key" validate:"required,max=50"`
+	Label    string   `json:"label" validate:"required,max=100"`
+	Type     string   `json:"type" validate:"required,oneof=string number boolean date enum"`
+	Required bool     `json:"required"`
+	Pattern  string   `json:"pattern" validate:"max=255"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+	Options  []string `json:"options"`
+}
+
+type AttributeDefinitionResponse struct {
+	UUID      uuid.UUID  `json:"uuid"`
+	Key       string     `json:"key"`
+	Label     string     `json:"label"`
+	Type      string     `json:"type"`
+	Required  bool       `json:"required"`
+	Pattern   string     `json:"pattern,omitempty"`
+	Min       *float64   `json:"min,omitempty"`
+	Max       *float64   `json:"max,omitempty"`
+	Options   []string   `json:"options,omitempty"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
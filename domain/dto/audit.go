@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditChange adalah nilai sebelum dan sesudah satu field pada entri audit
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type AuditLogFilter struct {
+	Page        int        `form:"page" validate:"omitempty,min=1"`
+	Limit       int        `form:"limit" validate:"omitempty,min=1,max=100"`
+	Actor       string     `form:"actor"`
+	ActorType   string     `form:"actorType" validate:"omitempty,oneof=user service system"`
+	Subject     string     `form:"subject" validate:"omitempty,uuid"`
+	SubjectType string     `form:"subjectType"`
+	Action      string     `form:"action"`
+	From        *time.Time `form:"from"`
+	To          *time.Time `form:"to"`
+}
+
+type AuditLogResponse struct {
+	UUID        uuid.UUID              `json:"uuid"`
+	ActorType   string                 `json:"actorType"`
+	ActorUUID   *uuid.UUID             `json:"actorUUID"`
+	ActorName   string                 `json:"actorName,omitempty"`
+	Action      string                 `json:"action"`
+	SubjectType string                 `json:"subjectType"`
+	SubjectUUID *uuid.UUID             `json:"subjectUUID"`
+	Changes     map[string]AuditChange `json:"changes,omitempty"`
+	Metadata    json.RawMessage        `json:"metadata,omitempty"`
+	RequestID   string                 `json:"requestId,omitempty"`
+	IPAddress   string                 `json:"ipAddress,omitempty"`
+	CreatedAt   *time.Time             `json:"createdAt"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ElevationRequest struct {
+	Role            string     `json:"role" validate:"required"`
+	DurationMinutes int        `json:"durationMinutes" validate:"required,min=1"`
+	Reason          string     `json:"reason" validate:"required,max=255"`
+	StartsAt        *time.Time `json:"startsAt"`
+}
+
+type ElevationFilter struct {
+	Status string `form:"status"`
+}
+
+type ElevationResponse struct {
+	UUID            uuid.UUID  `json:"uuid"`
+	UserUUID        uuid.UUID  `json:"userUUID"`
+	Username        string     `json:"username"`
+	Role            string     `json:"role"`
+	Reason          string     `json:"reason"`
+	DurationMinutes int        `json:"durationMinutes"`
+	Status          string     `json:"status"`
+	ReviewedBy      *uuid.UUID `json:"reviewedBy"`
+	ReviewedAt      *time.Time `json:"reviewedAt"`
+	StartsAt        *time.Time `json:"startsAt"`
+	ExpiresAt       *time.Time `json:"expiresAt"`
+	CreatedAt       *time.Time `json:"createdAt"`
+}
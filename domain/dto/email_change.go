@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailChangeRequest struct {
+	Email    string `json:"email" validate:"required,email,max=100"`
+	Password string `json:"password" validate:"required"`
+}
+
+type EmailChangeConfirmRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type EmailChangeResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	NewEmail    string     `json:"newEmail"`
+	Status      string     `json:"status"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GroupRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+}
+
+type GroupResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+type GroupMemberRequest struct {
+	UserUUID string `json:"userUUID" validate:"required,uuid"`
+}
+
+type GroupMemberResponse struct {
+	UUID     uuid.UUID  `json:"uuid"`
+	Name     string     `json:"name"`
+	Username string     `json:"username"`
+	Email    string     `json:"email"`
+	JoinedAt *time.Time `json:"joinedAt"`
+}
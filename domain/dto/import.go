@@ -0,0 +1,24 @@
+package dto
+
+type ImportRequest struct {
+	Format    string `form:"format" validate:"required,oneof=csv json"`
+	DryRun    bool   `form:"dryRun"`
+	BatchSize int    `form:"batchSize" validate:"omitempty,min=1,max=1000"`
+}
+
+type ImportRowResult struct {
+	Row      int    `json:"row"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Status   string `json:"status"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type ImportReport struct {
+	DryRun  bool              `json:"dryRun"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Skipped int               `json:"skipped"`
+	Failed  int               `json:"failed"`
+	Rows    []ImportRowResult `json:"rows"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationRequest struct {
+	Email string `json:"email" validate:"required,email,max=100"`
+	Name  string `json:"name" validate:"required,max=100"`
+	Role  string `json:"role" validate:"required"`
+}
+
+type InvitationFilter struct {
+	Status string `form:"status" validate:"omitempty,oneof=pending accepted revoked expired"`
+}
+
+// InvitationAcceptRequest diisi sendiri oleh user yang diundang; nama boleh dikosongkan
+// untuk memakai nama dari undangan
+type InvitationAcceptRequest struct {
+	Token           string         `json:"token" validate:"required"`
+	Name            string         `json:"name" validate:"omitempty,max=100"`
+	Username        string         `json:"username" validate:"required,max=20"`
+	PhoneNumber     string         `json:"phoneNumber" validate:"required,max=15"`
+	Password        string         `json:"password" validate:"required"`
+	ConfirmPassword string         `json:"confirmPassword" validate:"required"`
+	Attributes      map[string]any `json:"attributes"`
+	Locale          string         `json:"locale" validate:"omitempty,oneof=en id"`
+	Timezone        string         `json:"timezone" validate:"omitempty,timezone"`
+}
+
+type InvitationResponse struct {
+	UUID       uuid.UUID  `json:"uuid"`
+	Email      string     `json:"email"`
+	Name       string     `json:"name"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	InvitedBy  *uuid.UUID `json:"invitedBy"`
+	UserUUID   *uuid.UUID `json:"userUUID,omitempty"`
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  *time.Time `json:"createdAt"`
+}
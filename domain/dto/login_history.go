@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LoginHistoryRequest struct {
+	Page    int   `form:"page" validate:"omitempty,min=1"`
+	Limit   int   `form:"limit" validate:"omitempty,min=1,max=100"`
+	Success *bool `form:"success"`
+}
+
+type LoginAttemptResponse struct {
+	UUID          uuid.UUID  `json:"uuid"`
+	Method        string     `json:"method"`
+	Success       bool       `json:"success"`
+	FailureReason string     `json:"failureReason,omitempty"`
+	IPAddress     string     `json:"ipAddress"`
+	UserAgent     string     `json:"userAgent"`
+	Device        string     `json:"device"`
+	CreatedAt     *time.Time `json:"createdAt"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationRequest struct {
+	Code string `json:"code" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+type OrganizationResponse struct {
+	UUID      uuid.UUID  `json:"uuid"`
+	Code      string     `json:"code"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+type OrganizationMemberRequest struct {
+	UserUUID string   `json:"userUUID" validate:"required,uuid"`
+	Roles    []string `json:"roles" validate:"required,min=1"`
+}
+
+type OrganizationMemberResponse struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Name     string    `json:"name"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Roles    []string  `json:"roles"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ErasureRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+type ErasureResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	Status      string     `json:"status"`
+	ScheduledAt time.Time  `json:"scheduledAt"`
+	CancelledAt *time.Time `json:"cancelledAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+	CreatedAt   *time.Time `json:"createdAt"`
+}
@@ -1,19 +1,44 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type LoginRequest struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	Scope    string `json:"scope"`
+}
+
+type TokenRequest struct {
+	Scope string `json:"scope" validate:"required"`
 }
 
 type UserResponse struct {
-	UUID        uuid.UUID `json:"uuid"`
-	Name        string    `json:"name"`
-	Username    string    `json:"username"`
-	Email       string    `json:"email"`
-	Role        string    `json:"role"`
-	PhoneNumber string    `json:"phoneNumber"`
+	UUID        uuid.UUID      `json:"uuid"`
+	Name        string         `json:"name"`
+	Username    string         `json:"username"`
+	Email       string         `json:"email"`
+	Roles       []string       `json:"roles"`
+	PhoneNumber string         `json:"phoneNumber"`
+	Verified    bool           `json:"verified"`
+	Status      string         `json:"status"`
+	Avatar      *Avatar        `json:"avatar,omitempty"`
+	Attributes  map[string]any `json:"attributes,omitempty"`
+	Locale      string         `json:"locale,omitempty"`
+	Timezone    string         `json:"timezone,omitempty"`
+	LastLoginAt *time.Time     `json:"lastLoginAt,omitempty"`
+	CreatedAt   *time.Time     `json:"createdAt,omitempty"`
+	Version     uint           `json:"-"`
+}
+
+type Avatar struct {
+	Original string `json:"original"`
+	Small    string `json:"small"`
+	Medium   string `json:"medium"`
+	Large    string `json:"large"`
 }
 
 type LoginResponse struct {
@@ -22,13 +47,16 @@ type LoginResponse struct {
 }
 
 type RegisterRequest struct {
-	Name            string `json:"name" validate:"required"`
-	Email           string `json:"email" validate:"required,email"`
-	PhoneNumber     string `json:"phoneNumber" validate:"required"`
-	ConfirmPassword string `json:"confirmPassword" validate:"required"`
-	Username        string `json:"username" validate:"required"`
-	Password        string `json:"password" validate:"required"`
-	RoleID          uint
+	Name            string         `json:"name" validate:"required"`
+	Email           string         `json:"email" validate:"required,email"`
+	PhoneNumber     string         `json:"phoneNumber" validate:"required"`
+	ConfirmPassword string         `json:"confirmPassword" validate:"required"`
+	Username        string         `json:"username" validate:"required"`
+	Password        string         `json:"password" validate:"required"`
+	Attributes      map[string]any `json:"attributes"`
+	Locale          string         `json:"locale" validate:"omitempty,oneof=en id"`
+	Timezone        string         `json:"timezone" validate:"omitempty,timezone"`
+	RoleIDs         []uint         `json:"-"`
 }
 
 type RegisterRespose struct {
@@ -36,11 +64,67 @@ type RegisterRespose struct {
 }
 
 type UpdateUserRequest struct {
-	Name            string  `json:"name" validate:"required"`
-	Email           string  `json:"email" validate:"required,email"`
-	PhoneNumber     string  `json:"phoneNumber" validate:"required"`
-	ConfirmPassword *string `json:"confirmPassword,omitempty"`
-	Username        string  `json:"username" validate:"required"`
-	Password        *string `json:"password,omitempty"`
+	Name            string         `json:"name" validate:"required"`
+	Email           string         `json:"email" validate:"required,email"`
+	PhoneNumber     string         `json:"phoneNumber" validate:"required"`
+	ConfirmPassword *string        `json:"confirmPassword,omitempty"`
+	Username        string         `json:"username" validate:"required"`
+	Password        *string        `json:"password,omitempty"`
+	Attributes      map[string]any `json:"attributes"`
+	Locale          string         `json:"locale" validate:"omitempty,oneof=en id"`
+	Timezone        string         `json:"timezone" validate:"omitempty,timezone"`
 	RoleID          uint
+	// Version adalah versi yang diharapkan dari header If-Match; nil berarti tanpa syarat
+	Version *uint `json:"-"`
+}
+
+// UserPatchRequest adalah isi JSON Merge Patch untuk user. Field nil berarti tidak dikirim;
+// ClearAttributes terisi jika attributes dikirim sebagai null.
+type UserPatchRequest struct {
+	Name            *string        `json:"name" validate:"omitnil,min=1,max=100"`
+	Username        *string        `json:"username" validate:"omitnil,min=1,max=20"`
+	Email           *string        `json:"email" validate:"omitnil,email"`
+	PhoneNumber     *string        `json:"phoneNumber" validate:"omitnil,min=1,max=15"`
+	Password        *string        `json:"password" validate:"omitnil,min=1"`
+	ConfirmPassword *string        `json:"confirmPassword" validate:"required_with=Password"`
+	Attributes      map[string]any `json:"attributes"`
+	Locale          *string        `json:"locale" validate:"omitnil,oneof=en id"`
+	Timezone        *string        `json:"timezone" validate:"omitnil,timezone"`
+	ClearAttributes bool           `json:"-"`
+	Version         *uint          `json:"-"`
+}
+
+type UserListRequest struct {
+	Page        int               `form:"page" validate:"omitempty,min=1"`
+	Limit       int               `form:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor      string            `form:"cursor"`
+	Search      string            `form:"search"`
+	Role        string            `form:"role"`
+	Verified    *bool             `form:"verified"`
+	Status      string            `form:"status" validate:"omitempty,oneof=active suspended deactivated deleted"`
+	CreatedFrom *time.Time        `form:"createdFrom"`
+	CreatedTo   *time.Time        `form:"createdTo"`
+	Sort        string            `form:"sort" validate:"omitempty,oneof=name -name username -username email -email createdAt -createdAt"`
+	Attributes  map[string]string `form:"-"`
+}
+
+type UserExportRequest struct {
+	UserListRequest
+	Format string `form:"format" validate:"required,oneof=csv jsonl"`
+	Mask   bool   `form:"mask"`
+}
+
+type UserStatusRequest struct {
+	Status string     `json:"status" validate:"required,oneof=active suspended deactivated deleted"`
+	Reason string     `json:"reason" validate:"max=255"`
+	Until  *time.Time `json:"until"`
+}
+
+type UserStatusHistoryResponse struct {
+	FromStatus string     `json:"fromStatus"`
+	ToStatus   string     `json:"toStatus"`
+	Reason     string     `json:"reason"`
+	Until      *time.Time `json:"until"`
+	ChangedBy  *uuid.UUID `json:"changedBy"`
+	CreatedAt  *time.Time `json:"createdAt"`
 }
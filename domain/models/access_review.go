@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AccessReview struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	Name        string    `gorm:"type:varchar(100); not null"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	CreatedByID uint      `gorm:"not null"`
+	ClosedByID  *uint
+	ClosedAt    *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	CreatedBy   User               `gorm:"foreignKey:CreatedByID;references:ID"`
+	ClosedBy    *User              `gorm:"foreignKey:ClosedByID;references:ID"`
+	Items       []AccessReviewItem `gorm:"foreignKey:AccessReviewID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// AccessReviewItem adalah snapshot satu penugasan role istimewa saat review dibuat
+type AccessReviewItem struct {
+	ID               uint      `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID `gorm:"type:uuid; not null"`
+	AccessReviewID   uint      `gorm:"not null"`
+	UserID           uint      `gorm:"not null"`
+	RoleID           uint      `gorm:"not null"`
+	OrganizationID   *uint
+	Username         string `gorm:"type:varchar(20); not null"`
+	Email            string `gorm:"type:varchar(100); not null"`
+	RoleCode         string `gorm:"type:varchar(15); not null"`
+	OrganizationCode string `gorm:"type:varchar(30)"`
+	Reason           string `gorm:"type:varchar(255)"`
+	GrantedAt        *time.Time
+	ExpiresAt        *time.Time
+	Decision         string `gorm:"type:varchar(20); not null"`
+	Note             string `gorm:"type:varchar(255)"`
+	DecidedByID      *uint
+	DecidedAt        *time.Time
+	User             User  `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DecidedBy        *User `gorm:"foreignKey:DecidedByID;references:ID"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttributeDefinition adalah skema atribut profil tambahan yang disimpan di users.attributes.
+// Min dan Max berlaku sebagai panjang untuk string dan sebagai nilai untuk number.
+// Kolom key disimpan sebagai attribute_key karena KEY dicadangkan pada MySQL.
+type AttributeDefinition struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID `gorm:"type:uuid; not null"`
+	Key       string    `gorm:"column:attribute_key; type:varchar(50); not null; uniqueIndex"`
+	Label     string    `gorm:"type:varchar(100); not null"`
+	Type      string    `gorm:"type:varchar(20); not null"`
+	Required  bool      `gorm:"not null; default:false"`
+	Pattern   string    `gorm:"type:varchar(255)"`
+	Min       *float64
+	Max       *float64
+	Options   []string `gorm:"type:text; serializer:json"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
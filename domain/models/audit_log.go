@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+	errConstant "user-service/constants/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditLog struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID  `gorm:"type:uuid; not null"`
+	ActorType   string     `gorm:"type:varchar(20); not null"`
+	ActorUUID   *uuid.UUID `gorm:"type:uuid; index"`
+	ActorName   string     `gorm:"type:varchar(100)"`
+	Action      string     `gorm:"type:varchar(50); not null"`
+	SubjectType string     `gorm:"type:varchar(30); not null"`
+	SubjectUUID *uuid.UUID `gorm:"type:uuid; index"`
+	Changes     string     `gorm:"type:text"`
+	Metadata    string     `gorm:"type:text"`
+	RequestID   string     `gorm:"type:varchar(64)"`
+	IPAddress   string     `gorm:"type:varchar(45)"`
+	CreatedAt   *time.Time `gorm:"index"`
+}
+
+// BeforeUpdate menolak perubahan karena audit log hanya boleh ditambah
+func (a *AuditLog) BeforeUpdate(*gorm.DB) error {
+	return errConstant.ErrAuditLogImmutable
+}
+
+// BeforeDelete menolak penghapusan karena audit log hanya boleh ditambah
+func (a *AuditLog) BeforeDelete(*gorm.DB) error {
+	return errConstant.ErrAuditLogImmutable
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChange adalah permintaan penggantian email yang menunggu konfirmasi dari alamat baru.
+// Token konfirmasi hanya disimpan dalam bentuk hash.
+type EmailChange struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	UserID      uint      `gorm:"not null; index"`
+	NewEmail    string    `gorm:"type:varchar(100); not null"`
+	TokenHash   string    `gorm:"type:varchar(64); not null; uniqueIndex"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	ExpiresAt   time.Time `gorm:"not null"`
+	ConfirmedAt *time.Time
+	CancelledAt *time.Time
+	CreatedAt   *time.Time
+	User        User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ErasureRequest struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID `gorm:"type:uuid; not null"`
+	UserID      uint      `gorm:"not null;index"`
+	Status      string    `gorm:"type:varchar(20); not null"`
+	ScheduledAt time.Time `gorm:"not null"`
+	CancelledAt *time.Time
+	CompletedAt *time.Time
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	User        User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
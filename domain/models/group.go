@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Group struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID `gorm:"type:uuid; not null"`
+	OrganizationID *uint
+	Name           string `gorm:"type:varchar(100); not null"`
+	Description    string `gorm:"type:varchar(255)"`
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	Organization   *Organization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Members        []GroupMember `gorm:"foreignKey:GroupID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName menghindari kata kunci GROUPS yang dicadangkan pada sebagian database
+func (Group) TableName() string {
+	return "user_groups"
+}
+
+type GroupMember struct {
+	GroupID   uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"primaryKey"`
+	CreatedAt *time.Time
+	User      User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invitation adalah undangan dari admin untuk membuat akun dengan role yang sudah ditentukan.
+// Token pada link undangan hanya disimpan dalam bentuk hash.
+type Invitation struct {
+	ID             uint      `gorm:"primaryKey;autoIncrement"`
+	UUID           uuid.UUID `gorm:"type:uuid; not null"`
+	Email          string    `gorm:"type:varchar(100); not null; index"`
+	Name           string    `gorm:"type:varchar(100); not null"`
+	RoleID         uint      `gorm:"not null"`
+	OrganizationID *uint
+	TokenHash      string    `gorm:"type:varchar(64); not null; uniqueIndex"`
+	Status         string    `gorm:"type:varchar(20); not null"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	InvitedByID    *uint
+	UserID         *uint
+	AcceptedAt     *time.Time
+	RevokedAt      *time.Time
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	Role           Role          `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Organization   *Organization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	InvitedBy      *User         `gorm:"foreignKey:InvitedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	User           *User         `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
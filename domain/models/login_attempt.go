@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginAttempt adalah satu percobaan login, berhasil maupun gagal. UserID kosong jika
+// username yang dipakai tidak dikenal.
+type LoginAttempt struct {
+	ID            uint       `gorm:"primaryKey;autoIncrement"`
+	UUID          uuid.UUID  `gorm:"type:uuid; not null"`
+	UserID        *uint      `gorm:"index"`
+	Username      string     `gorm:"type:varchar(100); not null"`
+	Method        string     `gorm:"type:varchar(20); not null"`
+	Success       bool       `gorm:"not null"`
+	FailureReason string     `gorm:"type:varchar(30)"`
+	IPAddress     string     `gorm:"type:varchar(45)"`
+	UserAgent     string     `gorm:"type:varchar(255)"`
+	Device        string     `gorm:"type:varchar(100)"`
+	CreatedAt     *time.Time `gorm:"index"`
+	User          *User      `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Organization struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID `gorm:"type:uuid; not null"`
+	Code      string    `gorm:"type:varchar(30); not null"`
+	Name      string    `gorm:"type:varchar(100); not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+}
+
+type OrganizationMember struct {
+	OrganizationID uint `gorm:"primaryKey"`
+	UserID         uint `gorm:"primaryKey"`
+	RoleID         uint `gorm:"primaryKey"`
+	CreatedAt      *time.Time
+	Organization   Organization `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User           User         `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Role           Role         `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent adalah event yang disimpan dalam transaksi yang sama dengan perubahan datanya
+// lalu dikirim oleh job outbox. Payload berisi event utuh dalam bentuk JSON.
+type OutboxEvent struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	EventID       uuid.UUID `gorm:"type:uuid; not null; uniqueIndex"`
+	Type          string    `gorm:"type:varchar(100); not null"`
+	Payload       string    `gorm:"type:text; not null"`
+	Attempts      int       `gorm:"not null; default:0"`
+	NextAttemptAt time.Time `gorm:"not null; index"`
+	DeliveredAt   *time.Time
+	LastError     string `gorm:"type:text"`
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
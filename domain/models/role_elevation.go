@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoleElevation struct {
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID `gorm:"type:uuid; not null"`
+	UserID          uint      `gorm:"not null"`
+	RoleID          uint      `gorm:"not null"`
+	Reason          string    `gorm:"type:varchar(255); not null"`
+	DurationMinutes int       `gorm:"not null"`
+	Status          string    `gorm:"type:varchar(20); not null"`
+	ReviewedByID    *uint
+	ReviewedAt      *time.Time
+	StartsAt        *time.Time
+	ExpiresAt       *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	User            User  `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Role            Role  `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ReviewedBy      *User `gorm:"foreignKey:ReviewedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
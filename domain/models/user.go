@@ -4,18 +4,35 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// User adalah akun pengguna. UUID dijaga unique index di database; username dan email
+// unik per tenant dan dibandingkan tanpa membedakan huruf besar kecil.
 type User struct {
-	ID          uint      `gorm:"primaryKey;autoIncrement"`
-	UUID        uuid.UUID `gorm:"type:uuid; not null"`
-	Name        string    `gorm:"type:varchar(100); not null"`
-	Username    string    `gorm:"type:varchar(20); not null"`
-	Password    string    `gorm:"type:varchar(255); not null"`
-	PhoneNumber string    `gorm:"type:varchar(15); not null"`
-	Email       string    `gorm:"type:varchar(100); not null"`
-	RoleID      uint      `gorm:"type:uint;not null"`
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
-	Role        Role `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID `gorm:"type:uuid; not null"`
+	Name            string    `gorm:"type:varchar(100); not null"`
+	Username        string    `gorm:"type:varchar(20); not null"`
+	Password        string    `gorm:"type:varchar(255); not null"`
+	PhoneNumber     string    `gorm:"type:varchar(15); not null"`
+	Email           string    `gorm:"type:varchar(100); not null"`
+	OrganizationID  *uint
+	EmailVerifiedAt *time.Time
+	Status          string `gorm:"type:varchar(20); not null; default:active"`
+	SuspendedReason string `gorm:"type:varchar(255)"`
+	SuspendedUntil  *time.Time
+	AvatarKey       string         `gorm:"type:varchar(255)"`
+	Attributes      map[string]any `gorm:"type:jsonb; serializer:json"`
+	Version         uint           `gorm:"not null; default:1"`
+	TokensRevokedAt *time.Time
+	LastLoginAt     *time.Time
+	Locale          string `gorm:"type:varchar(10)"`
+	Timezone        string `gorm:"type:varchar(64)"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	DeletedAt       gorm.DeletedAt       `gorm:"index"`
+	Organization    *Organization        `gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	UserRoles       []UserRole           `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Memberships     []OrganizationMember `gorm:"foreignKey:UserID;references:ID"`
 }
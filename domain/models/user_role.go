@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type UserRole struct {
+	UserID    uint `gorm:"primaryKey"`
+	RoleID    uint `gorm:"primaryKey"`
+	StartsAt  *time.Time
+	ExpiresAt *time.Time
+	CreatedAt *time.Time
+	Role      Role `gorm:"foreignKey:RoleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
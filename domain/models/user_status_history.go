@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type UserStatusHistory struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	UserID      uint   `gorm:"not null;index"`
+	FromStatus  string `gorm:"type:varchar(20); not null"`
+	ToStatus    string `gorm:"type:varchar(20); not null"`
+	Reason      string `gorm:"type:varchar(255)"`
+	Until       *time.Time
+	ChangedByID *uint
+	CreatedAt   *time.Time
+	ChangedBy   *User `gorm:"foreignKey:ChangedByID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"time"
+	"user-service/config"
+	"user-service/constants"
+	"user-service/services"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Registry struct {
+	service services.IServiceRegistry
+}
+
+type IJobRegistry interface {
+	Start(context.Context)
+}
+
+func NewJobRegistry(service services.IServiceRegistry) IJobRegistry {
+	return &Registry{service: service}
+}
+
+func (r *Registry) Start(ctx context.Context) {
+	// elevasi yang tidak pernah dicabut berarti role sementara berlaku selamanya,
+	// jadi job ini tetap berjalan walaupun intervalnya tidak diatur
+	go run(ctx, "role elevation sweep", sweepInterval(config.Config.ElevationSweepSecond, constants.DefaultElevationSweepSecond),
+		r.service.GetElevation().ExpireElevations)
+	go run(ctx, "suspension sweep", time.Duration(config.Config.SuspensionSweepSecond)*time.Second,
+		r.service.GetUser().ReactivateExpiredSuspensions)
+	// erasure yang sudah diterima harus diproses walaupun intervalnya tidak diatur
+	go run(ctx, "erasure sweep", sweepInterval(config.Config.ErasureSweepSecond, constants.DefaultErasureSweepSecond),
+		r.service.GetPrivacy().ProcessErasures)
+	go run(ctx, "event outbox delivery", sweepInterval(config.Config.OutboxSweepSecond, constants.DefaultOutboxSweepSecond),
+		r.service.GetOutbox().Deliver)
+	go run(ctx, "login history sweep", time.Duration(config.Config.LoginHistorySweepSecond)*time.Second,
+		r.service.GetUser().PruneLoginHistory)
+}
+
+// sweepInterval mengubah detik dari config menjadi durasi; 0 berarti memakai fallback
+func sweepInterval(seconds, fallback int) time.Duration {
+	if seconds == 0 {
+		seconds = fallback
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// run menjalankan job secara berkala sampai ctx selesai; interval <= 0 menonaktifkan job
+func run(ctx context.Context, name string, interval time.Duration, job func(context.Context) error) {
+	if interval <= 0 {
+		logrus.Infof("job %s disabled", name)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				logrus.Errorf("job %s failed: %v", name, err)
+			}
+		}
+	}
+}
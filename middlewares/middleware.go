@@ -4,22 +4,36 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
+	"user-service/common/i18n"
 	"user-service/common/response"
 	"user-service/config"
 	"user-service/constants"
 	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/repositories"
 	services "user-service/services/user"
 
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
+var repository repositories.IRepositoryRegistry
+
+// Init memasang repository yang dipakai middleware untuk membaca data terbaru dari database
+func Init(repositoryRegistry repositories.IRepositoryRegistry) {
+	repository = repositoryRegistry
+}
+
 func HandlePanic() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
@@ -27,7 +41,7 @@ func HandlePanic() gin.HandlerFunc {
 				logrus.Errorf("Recovered From Panic: %v", r)
 				c.JSON(http.StatusInternalServerError, response.Response{
 					Status:  constants.Error,
-					Message: errConstant.ErrInternalServerError.Error(),
+					Message: translate(c, errConstant.ErrInternalServerError.Error()),
 				})
 				c.Abort()
 			}
@@ -36,6 +50,32 @@ func HandlePanic() gin.HandlerFunc {
 	}
 }
 
+// translate menerjemahkan pesan response ke locale request
+func translate(c *gin.Context, message string) string {
+	return i18n.T(i18n.Locale(c.Request.Context()), message)
+}
+
+// RequestInfo menyimpan request ID, IP klien, dan user agent di context request agar bisa
+// dicatat oleh audit log dan riwayat login. Request ID dari
+// header dipakai ulang jika ada. Locale dan zona waktu awal diambil dari header
+// Accept-Language dan X-Timezone, lalu ditimpa preferensi user setelah autentikasi.
+func RequestInfo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(constants.XRequestID)
+		if requestID == "" || len(requestID) > 64 {
+			requestID = uuid.NewString()
+		}
+		c.Header(constants.XRequestID, requestID)
+
+		ctx := context.WithValue(c.Request.Context(), constants.RequestID, requestID)
+		ctx = context.WithValue(ctx, constants.ClientIP, c.ClientIP())
+		ctx = context.WithValue(ctx, constants.UserAgent, c.Request.UserAgent())
+		ctx = i18n.WithPreferences(ctx, i18n.ParseAcceptLanguage(c.GetHeader(constants.AcceptLang)), c.GetHeader(constants.XTimezone))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
 func RateLimit(lmt *limiter.Limiter) gin.HandlerFunc {
     return func(c *gin.Context) {
         // Disable rate limiter for localhost
@@ -48,7 +88,7 @@ func RateLimit(lmt *limiter.Limiter) gin.HandlerFunc {
         if httpError != nil {
             c.JSON(http.StatusTooManyRequests, response.Response{
                 Status:  constants.Error,
-                Message: "Too many requests, please try again later",
+                Message: translate(c, "Too many requests, please try again later"),
                 Data:    nil,
             })
             c.Abort()
@@ -69,7 +109,15 @@ func extractBearerToken(token string) string {
 func responseUnauthorized(c *gin.Context, message string) {
 	c.JSON(http.StatusUnauthorized, response.Response{
 		Status:  constants.Error,
-		Message: message,
+		Message: translate(c, message),
+	})
+	c.Abort()
+}
+
+func responseForbidden(c *gin.Context) {
+	c.JSON(http.StatusForbidden, response.Response{
+		Status:  constants.Error,
+		Message: translate(c, errConstant.ErrForbidden.Error()),
 	})
 	c.Abort()
 }
@@ -89,6 +137,11 @@ func validateAPIKey(c *gin.Context) error {
 	if apiKey != resultHash {
 		return errConstant.ErrUnauthorized
 	}
+	// nama service baru dipercaya sebagai pelaku audit setelah signature-nya terbukti valid
+	if serviceName != "" {
+		ctx := context.WithValue(c.Request.Context(), constants.ServiceName, serviceName)
+		c.Request = c.Request.WithContext(ctx)
+	}
 	return nil
 }
 
@@ -110,17 +163,64 @@ func validateBarierToken(c *gin.Context, token string) error {
 		}
 		jwtSecret := []byte(config.Config.JwtSecretKey)
 		return jwtSecret, nil
-	})
+	}, jwt.WithExpirationRequired()) // klaim exp wajib ada karena dipakai untuk TokenExpiresAt
 	if err != nil || !tokenJWT.Valid {
 		return errConstant.ErrUnauthorized
 	}
 
-	userLogin := c.Request.WithContext(context.WithValue(c.Request.Context(), constants.UserLogin, claims.User))
-	c.Request = userLogin
+	ctx := context.WithValue(c.Request.Context(), constants.UserLogin, claims.User)
+	tenant, hasTenant := ctx.Value(constants.Tenant).(uuid.UUID)
+	if claims.Organization != nil {
+		// token ber-tenant hanya berlaku untuk tenant miliknya
+		if hasTenant && tenant != *claims.Organization {
+			return errConstant.ErrForbidden
+		}
+		ctx = context.WithValue(ctx, constants.Tenant, *claims.Organization)
+	} else if hasTenant && !slices.Contains(claims.User.Roles, constants.RoleAdmin) {
+		// hanya admin platform yang boleh memilih tenant lewat header
+		return errConstant.ErrForbidden
+	}
+	if claims.Groups != nil {
+		ctx = context.WithValue(ctx, constants.UserGroup, claims.Groups)
+	}
+	// token lama tanpa klaim scope diperlakukan sebagai token dengan seluruh scope
+	scopes := strings.Fields(claims.Scope)
+	if len(scopes) == 0 {
+		scopes = constants.Scopes
+	}
+	ctx = context.WithValue(ctx, constants.Scope, scopes)
+	ctx = context.WithValue(ctx, constants.TokenExpiresAt, claims.ExpiresAt.Time)
+	// token tanpa klaim iat dianggap terbit sebelum pencabutan token mana pun
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+	ctx = context.WithValue(ctx, constants.TokenIssuedAt, issuedAt)
+	c.Request = c.Request.WithContext(ctx)
 	c.Set(constants.Token, token)
 	return nil
 }
 
+// validateUserStatus memastikan pemilik token masih aktif, sehingga suspend atau
+// penghapusan akun langsung berlaku tanpa menunggu token kedaluwarsa. Preferensi locale
+// dan zona waktu user ikut dipasang ke context request.
+func validateUserStatus(c *gin.Context) error {
+	userLogin := c.Request.Context().Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := repository.GetUser().FindStatusByUUID(c.Request.Context(), userLogin.UUID)
+	if errors.Is(err, errConstant.ErrUserNotFound) {
+		return errConstant.ErrUserDeleted
+	}
+	if err != nil {
+		return err
+	}
+	issuedAt := c.Request.Context().Value(constants.TokenIssuedAt).(time.Time)
+	if user.TokensRevokedAt != nil && issuedAt.Before(*user.TokensRevokedAt) {
+		return errConstant.ErrTokenRevoked
+	}
+	c.Request = c.Request.WithContext(i18n.WithPreferences(c.Request.Context(), user.Locale, user.Timezone))
+	return services.CheckUserStatus(user)
+}
+
 func Authenticated() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var err error
@@ -131,6 +231,10 @@ func Authenticated() gin.HandlerFunc {
 		}
 
 		err = validateBarierToken(c, token)
+		if errors.Is(err, errConstant.ErrForbidden) {
+			responseForbidden(c)
+			return
+		}
 		if err != nil {
 			responseUnauthorized(c, err.Error())
 			return
@@ -142,6 +246,138 @@ func Authenticated() gin.HandlerFunc {
 			return
 		}
 
+		err = validateUserStatus(c)
+		if errors.Is(err, errConstant.ErrTokenRevoked) {
+			responseUnauthorized(c, err.Error())
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusForbidden, response.Response{
+				Status:  constants.Error,
+				Message: translate(c, err.Error()),
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AccessRule adalah satu syarat akses yang diperiksa oleh CheckAccess
+type AccessRule func(*gin.Context) bool
+
+// HasRole terpenuhi jika salah satu role user cocok dengan roles
+func HasRole(roles ...string) AccessRule {
+	return func(c *gin.Context) bool {
+		user, ok := c.Request.Context().Value(constants.UserLogin).(*dto.UserResponse)
+		return ok && slices.ContainsFunc(user.Roles, func(role string) bool {
+			return slices.Contains(roles, role)
+		})
+	}
+}
+
+// InGroup terpenuhi jika user menjadi anggota salah satu grup (UUID) pada groups.
+// Grup dibaca dari token jika tersedia, selain itu dari database.
+func InGroup(groups ...string) AccessRule {
+	return func(c *gin.Context) bool {
+		userGroups, ok := c.Request.Context().Value(constants.UserGroup).([]uuid.UUID)
+		if !ok {
+			user, ok := c.Request.Context().Value(constants.UserLogin).(*dto.UserResponse)
+			if !ok {
+				return false
+			}
+			var err error
+			userGroups, err = repository.GetGroup().FindUUIDsByUser(c.Request.Context(), user.UUID)
+			if err != nil {
+				return false
+			}
+		}
+		return slices.ContainsFunc(userGroups, func(group uuid.UUID) bool {
+			return slices.Contains(groups, group.String())
+		})
+	}
+}
+
+// CheckAccess meloloskan request jika salah satu rule terpenuhi
+func CheckAccess(rules ...AccessRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, rule := range rules {
+			if rule(c) {
+				c.Next()
+				return
+			}
+		}
+		responseForbidden(c)
+	}
+}
+
+// CheckRole meloloskan request jika salah satu role user cocok dengan roles
+func CheckRole(roles ...string) gin.HandlerFunc {
+	return CheckAccess(HasRole(roles...))
+}
+
+// Tenant membaca tenant yang dipilih lewat header dan menyimpannya di context request
+func Tenant() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(constants.XOrganization)
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		tenant, err := uuid.Parse(header)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, response.Response{
+				Status:  constants.Error,
+				Message: translate(c, errConstant.ErrInvalidOrganization.Error()),
+			})
+			c.Abort()
+			return
+		}
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), constants.Tenant, tenant))
+		c.Next()
+	}
+}
+
+// CheckOrganization memastikan request ber-tenant hanya mengakses organisasi
+// tenant tersebut; request tanpa tenant (platform) boleh mengakses semuanya
+func CheckOrganization() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant, ok := c.Request.Context().Value(constants.Tenant).(uuid.UUID)
+		if ok && c.Param("uuid") != tenant.String() {
+			responseForbidden(c)
+			return
+		}
+		c.Next()
+	}
+}
+
+// CheckPlatform menolak request yang berjalan di dalam tenant
+func CheckPlatform() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Request.Context().Value(constants.Tenant).(uuid.UUID); ok {
+			responseForbidden(c)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireScope meloloskan request hanya jika token memiliki seluruh scopes
+func RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tokenScopes, _ := c.Request.Context().Value(constants.Scope).([]string)
+		for _, scope := range scopes {
+			if !slices.Contains(tokenScopes, scope) {
+				c.JSON(http.StatusForbidden, response.Response{
+					Status:  constants.Error,
+					Message: translate(c, errConstant.ErrInsufficientScope.Error()),
+				})
+				c.Abort()
+				return
+			}
+		}
 		c.Next()
 	}
 }
@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AccessReviewRepository struct {
+	db *gorm.DB
+}
+
+type IAccessReviewRepository interface {
+	Create(context.Context, *dto.AccessReviewRequest, uint) (*models.AccessReview, error)
+	FindAll(context.Context) ([]models.AccessReview, error)
+	FindByUUID(context.Context, string) (*models.AccessReview, error)
+	Decide(context.Context, *models.AccessReviewItem, *dto.AccessReviewDecisionRequest, uint) error
+	Close(context.Context, *models.AccessReview, uint) error
+}
+
+func NewAccessReviewRepository(db *gorm.DB) IAccessReviewRepository {
+	return &AccessReviewRepository{db: db}
+}
+
+// Create membuat review baru berisi snapshot seluruh penugasan role istimewa,
+// baik role global maupun role di dalam organisasi
+func (r *AccessReviewRepository) Create(ctx context.Context, req *dto.AccessReviewRequest, createdByID uint) (*models.AccessReview, error) {
+	var globalItems, organizationItems []models.AccessReviewItem
+	err := r.db.WithContext(ctx).
+		Table("user_roles ur").
+		Select(`ur.user_id, ur.role_id, u.username, u.email, r.code AS role_code,
+			ur.created_at AS granted_at, ur.expires_at,
+			COALESCE((SELECT re.reason FROM role_elevations re
+				WHERE re.user_id = ur.user_id AND re.role_id = ur.role_id
+				AND re.status = ? AND re.expires_at = ur.expires_at
+				ORDER BY re.id DESC LIMIT 1), '') AS reason`, constants.ElevationApproved).
+		Joins("JOIN roles r ON r.id = ur.role_id").
+		Joins("JOIN users u ON u.id = ur.user_id").
+		Where("r.privileged = ?", true).
+		Order("u.username").
+		Scan(&globalItems).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	err = r.db.WithContext(ctx).
+		Table("organization_members om").
+		Select(`om.user_id, om.role_id, om.organization_id, u.username, u.email, r.code AS role_code,
+			o.code AS organization_code, om.created_at AS granted_at`).
+		Joins("JOIN roles r ON r.id = om.role_id").
+		Joins("JOIN users u ON u.id = om.user_id").
+		Joins("JOIN organizations o ON o.id = om.organization_id").
+		Where("r.privileged = ?", true).
+		Order("o.code, u.username").
+		Scan(&organizationItems).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	review := &models.AccessReview{
+		UUID:        uuid.New(),
+		Name:        req.Name,
+		Status:      constants.AccessReviewOpen,
+		CreatedByID: createdByID,
+		Items:       append(globalItems, organizationItems...),
+	}
+	for i := range review.Items {
+		review.Items[i].UUID = uuid.New()
+		review.Items[i].Decision = constants.DecisionPending
+	}
+
+	err = r.db.WithContext(ctx).Create(review).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return r.FindByUUID(ctx, review.UUID.String())
+}
+
+// FindAll mengambil seluruh review tanpa item, terbaru lebih dulu
+func (r *AccessReviewRepository) FindAll(ctx context.Context) ([]models.AccessReview, error) {
+	var reviews []models.AccessReview
+	err := r.db.WithContext(ctx).
+		Preload("CreatedBy").
+		Preload("ClosedBy").
+		Order("created_at DESC").
+		Find(&reviews).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return reviews, nil
+}
+
+// FindByUUID mencari review beserta seluruh item-nya, error jika tidak ditemukan
+func (r *AccessReviewRepository) FindByUUID(ctx context.Context, uuid string) (*models.AccessReview, error) {
+	var review models.AccessReview
+	err := r.db.WithContext(ctx).
+		Preload("CreatedBy").
+		Preload("ClosedBy").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Items.User").
+		Preload("Items.DecidedBy").
+		Where("uuid = ?", uuid).
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrAccessReviewNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &review, nil
+}
+
+// Decide menyimpan keputusan keep/revoke untuk satu item review
+func (r *AccessReviewRepository) Decide(ctx context.Context, item *models.AccessReviewItem, req *dto.AccessReviewDecisionRequest, deciderID uint) error {
+	now := time.Now()
+	item.Decision = req.Decision
+	item.Note = req.Note
+	item.DecidedByID = &deciderID
+	item.DecidedAt = &now
+
+	err := r.db.WithContext(ctx).
+		Model(item).
+		Select("Decision", "Note", "DecidedByID", "DecidedAt").
+		Updates(item).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// Close menutup review dan mencabut seluruh penugasan yang diputuskan revoke dalam satu transaksi
+func (r *AccessReviewRepository) Close(ctx context.Context, review *models.AccessReview, closedByID uint) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range review.Items {
+			if item.Decision != constants.DecisionRevoke {
+				continue
+			}
+
+			var err error
+			if item.OrganizationID != nil {
+				err = tx.Where("organization_id = ? AND user_id = ? AND role_id = ?", *item.OrganizationID, item.UserID, item.RoleID).
+					Delete(&models.OrganizationMember{}).Error
+			} else {
+				err = tx.Where("user_id = ? AND role_id = ?", item.UserID, item.RoleID).
+					Delete(&models.UserRole{}).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		review.Status = constants.AccessReviewClosed
+		review.ClosedByID = &closedByID
+		review.ClosedAt = &now
+		return tx.Model(review).
+			Select("Status", "ClosedByID", "ClosedAt").
+			Updates(review).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
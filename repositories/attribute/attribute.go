@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AttributeRepository struct {
+	db *gorm.DB
+}
+
+type IAttributeRepository interface {
+	Create(context.Context, *dto.AttributeDefinitionRequest) (*models.AttributeDefinition, error)
+	Update(context.Context, *dto.AttributeDefinitionRequest, string) (*models.AttributeDefinition, error)
+	Delete(context.Context, string) error
+	FindAll(context.Context) ([]models.AttributeDefinition, error)
+	FindByUUID(context.Context, string) (*models.AttributeDefinition, error)
+	FindByKey(context.Context, string) (*models.AttributeDefinition, error)
+}
+
+func NewAttributeRepository(db *gorm.DB) IAttributeRepository {
+	return &AttributeRepository{db: db}
+}
+
+// Create menambahkan definisi atribut baru
+func (r *AttributeRepository) Create(ctx context.Context, req *dto.AttributeDefinitionRequest) (*models.AttributeDefinition, error) {
+	definition := &models.AttributeDefinition{
+		UUID:     uuid.New(),
+		Key:      req.Key,
+		Label:    req.Label,
+		Type:     req.Type,
+		Required: req.Required,
+		Pattern:  req.Pattern,
+		Min:      req.Min,
+		Max:      req.Max,
+		Options:  req.Options,
+	}
+
+	err := r.db.WithContext(ctx).Create(definition).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return definition, nil
+}
+
+// Update mengubah definisi atribut; key dan tipe tidak dapat diubah karena sudah dipakai data user
+func (r *AttributeRepository) Update(ctx context.Context, req *dto.AttributeDefinitionRequest, uuid string) (*models.AttributeDefinition, error) {
+	definition, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(definition).
+		Select("Label", "Required", "Pattern", "Min", "Max", "Options").
+		Updates(models.AttributeDefinition{
+			Label:    req.Label,
+			Required: req.Required,
+			Pattern:  req.Pattern,
+			Min:      req.Min,
+			Max:      req.Max,
+			Options:  req.Options,
+		}).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return definition, nil
+}
+
+// Delete menghapus definisi atribut; nilai yang sudah tersimpan di user tidak ikut dihapus
+func (r *AttributeRepository) Delete(ctx context.Context, uuid string) error {
+	definition, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.WithContext(ctx).Delete(definition).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindAll mengambil seluruh definisi atribut
+func (r *AttributeRepository) FindAll(ctx context.Context) ([]models.AttributeDefinition, error) {
+	var definitions []models.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Order("attribute_key").
+		Find(&definitions).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return definitions, nil
+}
+
+// FindByUUID mencari definisi atribut berdasarkan UUID, error jika tidak ditemukan
+func (r *AttributeRepository) FindByUUID(ctx context.Context, uuid string) (*models.AttributeDefinition, error) {
+	var definition models.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Where("uuid = ?", uuid).
+		First(&definition).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrAttributeNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &definition, nil
+}
+
+// FindByKey mencari definisi atribut berdasarkan key, mengembalikan nil jika tidak ditemukan
+func (r *AttributeRepository) FindByKey(ctx context.Context, key string) (*models.AttributeDefinition, error) {
+	var definition models.AttributeDefinition
+	err := r.db.WithContext(ctx).
+		Where("attribute_key = ?", key).
+		First(&definition).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &definition, nil
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const defaultAuditLimit = 20
+
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+type IAuditRepository interface {
+	Record(context.Context, string, string, *uuid.UUID, any) error
+	RecordChanges(context.Context, string, string, *uuid.UUID, map[string]dto.AuditChange, any) error
+	FindAll(context.Context, *dto.AuditLogFilter) ([]models.AuditLog, *response.Pagination, error)
+}
+
+func NewAuditRepository(db *gorm.DB) IAuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Record menambahkan satu entri audit. Pelaku diambil dari user login di context,
+// tanpa user login dari service pemanggil, dan selain itu dicatat sebagai system.
+func (r *AuditRepository) Record(ctx context.Context, action, subjectType string, subjectUUID *uuid.UUID, metadata any) error {
+	return r.RecordChanges(ctx, action, subjectType, subjectUUID, nil, metadata)
+}
+
+// RecordChanges menambahkan satu entri audit beserta diff per field. Diff harus sudah
+// disamarkan oleh pemanggil untuk field rahasia.
+func (r *AuditRepository) RecordChanges(
+	ctx context.Context,
+	action, subjectType string,
+	subjectUUID *uuid.UUID,
+	changes map[string]dto.AuditChange,
+	metadata any,
+) error {
+	auditLog := &models.AuditLog{
+		UUID:        uuid.New(),
+		ActorType:   constants.ActorSystem,
+		Action:      action,
+		SubjectType: subjectType,
+		SubjectUUID: subjectUUID,
+	}
+	if serviceName, ok := ctx.Value(constants.ServiceName).(string); ok {
+		auditLog.ActorType = constants.ActorService
+		auditLog.ActorName = serviceName
+	}
+	// user hanya dicatat lewat UUID; username adalah data pribadi yang tidak bisa dihapus
+	// dari audit log saat erasure
+	if userLogin, ok := ctx.Value(constants.UserLogin).(*dto.UserResponse); ok {
+		auditLog.ActorType = constants.ActorUser
+		auditLog.ActorUUID = &userLogin.UUID
+		auditLog.ActorName = ""
+	}
+	auditLog.RequestID, _ = ctx.Value(constants.RequestID).(string)
+	auditLog.IPAddress, _ = ctx.Value(constants.ClientIP).(string)
+
+	if len(changes) > 0 {
+		data, err := json.Marshal(changes)
+		if err != nil {
+			return errWrap.WrapError(err)
+		}
+		auditLog.Changes = string(data)
+	}
+	if metadata != nil {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return errWrap.WrapError(err)
+		}
+		auditLog.Metadata = string(data)
+	}
+
+	err := r.db.WithContext(ctx).Create(auditLog).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindAll mengambil audit log terbaru lebih dulu dengan filter pelaku, subjek, dan waktu.
+// Filter actor berupa UUID user, username, atau nama service.
+func (r *AuditRepository) FindAll(ctx context.Context, filter *dto.AuditLogFilter) ([]models.AuditLog, *response.Pagination, error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+	meta := &response.Pagination{Page: filter.Page, Limit: limit}
+	if meta.Page == 0 {
+		meta.Page = 1
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{})
+	if filter.Actor != "" {
+		if actorUUID, err := uuid.Parse(filter.Actor); err == nil {
+			query = query.Where("actor_uuid = ?", actorUUID)
+		} else {
+			query = query.Where("actor_name = ? OR actor_uuid IN (?)", filter.Actor,
+				r.db.Model(&models.User{}).Unscoped().Select("uuid").Where("LOWER(username) = LOWER(?)", filter.Actor))
+		}
+	}
+	if filter.ActorType != "" {
+		query = query.Where("actor_type = ?", filter.ActorType)
+	}
+	if filter.Subject != "" {
+		query = query.Where("subject_uuid = ?", filter.Subject)
+	}
+	if filter.SubjectType != "" {
+		query = query.Where("subject_type = ?", filter.SubjectType)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	err := query.Session(&gorm.Session{}).Count(&meta.Total).Error
+	if err != nil {
+		return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	meta.TotalPages = int((meta.Total + int64(limit) - 1) / int64(limit))
+	meta.HasNext = meta.Page < meta.TotalPages
+
+	var auditLogs []models.AuditLog
+	err = query.
+		Order("created_at DESC, id DESC").
+		Offset((meta.Page - 1) * limit).
+		Limit(limit).
+		Find(&auditLogs).Error
+	if err != nil {
+		return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return auditLogs, meta, nil
+}
@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ElevationRepository struct {
+	db *gorm.DB
+}
+
+type IElevationRepository interface {
+	Create(context.Context, uint, uint, *dto.ElevationRequest) (*models.RoleElevation, error)
+	FindAll(context.Context, *dto.ElevationFilter) ([]models.RoleElevation, error)
+	FindByUUID(context.Context, string) (*models.RoleElevation, error)
+	FindExpired(context.Context, time.Time) ([]models.RoleElevation, error)
+	Approve(context.Context, *models.RoleElevation, uint, time.Time, time.Time) error
+	Reject(context.Context, *models.RoleElevation, uint) error
+	Expire(context.Context, *models.RoleElevation) error
+}
+
+func NewElevationRepository(db *gorm.DB) IElevationRepository {
+	return &ElevationRepository{db: db}
+}
+
+// Create menyimpan permintaan elevasi role dengan status pending
+func (r *ElevationRepository) Create(ctx context.Context, userID, roleID uint, req *dto.ElevationRequest) (*models.RoleElevation, error) {
+	elevation := &models.RoleElevation{
+		UUID:            uuid.New(),
+		UserID:          userID,
+		RoleID:          roleID,
+		Reason:          req.Reason,
+		DurationMinutes: req.DurationMinutes,
+		Status:          constants.ElevationPending,
+		StartsAt:        req.StartsAt,
+	}
+
+	err := r.db.WithContext(ctx).Create(elevation).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return r.FindByUUID(ctx, elevation.UUID.String())
+}
+
+// FindAll mengambil permintaan elevasi, terbaru lebih dulu
+func (r *ElevationRepository) FindAll(ctx context.Context, filter *dto.ElevationFilter) ([]models.RoleElevation, error) {
+	var elevations []models.RoleElevation
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Role").
+		Preload("ReviewedBy")
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	err := query.Order("created_at DESC").Find(&elevations).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return elevations, nil
+}
+
+// FindByUUID mencari permintaan elevasi berdasarkan UUID, error jika tidak ditemukan
+func (r *ElevationRepository) FindByUUID(ctx context.Context, uuid string) (*models.RoleElevation, error) {
+	var elevation models.RoleElevation
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Role").
+		Preload("ReviewedBy").
+		Where("uuid = ?", uuid).
+		First(&elevation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrElevationNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &elevation, nil
+}
+
+// FindExpired mengambil elevasi yang sudah disetujui namun telah melewati expires_at
+func (r *ElevationRepository) FindExpired(ctx context.Context, now time.Time) ([]models.RoleElevation, error) {
+	var elevations []models.RoleElevation
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Role").
+		Where("status = ? AND expires_at <= ?", constants.ElevationApproved, now).
+		Find(&elevations).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return elevations, nil
+}
+
+// Approve menyetujui elevasi dan memberikan role sementara kepada user dalam satu transaksi
+func (r *ElevationRepository) Approve(ctx context.Context, elevation *models.RoleElevation, reviewerID uint, startsAt, expiresAt time.Time) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.UserRole
+		err := tx.Where("user_id = ? AND role_id = ?", elevation.UserID, elevation.RoleID).First(&existing).Error
+		if err == nil && existing.ExpiresAt == nil {
+			return errConstant.ErrRoleAlreadyAssigned
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		err = tx.Save(&models.UserRole{
+			UserID:    elevation.UserID,
+			RoleID:    elevation.RoleID,
+			StartsAt:  &startsAt,
+			ExpiresAt: &expiresAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		elevation.Status = constants.ElevationApproved
+		elevation.ReviewedByID = &reviewerID
+		elevation.ReviewedAt = &now
+		elevation.StartsAt = &startsAt
+		elevation.ExpiresAt = &expiresAt
+		// status pending dicek ulang agar dua review bersamaan tidak sama-sama berhasil
+		result := tx.Model(elevation).
+			Where("status = ?", constants.ElevationPending).
+			Select("Status", "ReviewedByID", "ReviewedAt", "StartsAt", "ExpiresAt").
+			Updates(elevation)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrElevationNotPending
+		}
+		return nil
+	})
+	if errors.Is(err, errConstant.ErrRoleAlreadyAssigned) || errors.Is(err, errConstant.ErrElevationNotPending) {
+		return errWrap.WrapError(err)
+	}
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// Reject menolak permintaan elevasi yang masih pending
+func (r *ElevationRepository) Reject(ctx context.Context, elevation *models.RoleElevation, reviewerID uint) error {
+	now := time.Now()
+	elevation.Status = constants.ElevationRejected
+	elevation.ReviewedByID = &reviewerID
+	elevation.ReviewedAt = &now
+
+	result := r.db.WithContext(ctx).
+		Model(elevation).
+		Where("status = ?", constants.ElevationPending).
+		Select("Status", "ReviewedByID", "ReviewedAt").
+		Updates(elevation)
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errWrap.WrapError(errConstant.ErrElevationNotPending)
+	}
+	return nil
+}
+
+// Expire mencabut role sementara milik elevasi dan menandai elevasi sebagai expired.
+// Role permanen yang diberikan belakangan (expires_at NULL) tidak ikut terhapus.
+func (r *ElevationRepository) Expire(ctx context.Context, elevation *models.RoleElevation) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND role_id = ? AND expires_at IS NOT NULL AND expires_at <= ?",
+			elevation.UserID, elevation.RoleID, elevation.ExpiresAt).
+			Delete(&models.UserRole{}).Error
+		if err != nil {
+			return err
+		}
+
+		elevation.Status = constants.ElevationExpired
+		return tx.Model(elevation).Select("Status").Updates(elevation).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
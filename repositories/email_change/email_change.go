@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type EmailChangeRepository struct {
+	db *gorm.DB
+}
+
+type IEmailChangeRepository interface {
+	Create(context.Context, uint, string, string, time.Time) (*models.EmailChange, error)
+	FindPending(context.Context, uint) (*models.EmailChange, error)
+	FindPendingByToken(context.Context, string) (*models.EmailChange, error)
+	Cancel(context.Context, *models.EmailChange) error
+	Confirm(context.Context, *models.EmailChange) error
+}
+
+func NewEmailChangeRepository(db *gorm.DB) IEmailChangeRepository {
+	return &EmailChangeRepository{db: db}
+}
+
+// Create membuat permintaan penggantian email baru dan membatalkan permintaan lama yang masih pending
+func (r *EmailChangeRepository) Create(ctx context.Context, userID uint, newEmail, tokenHash string, expiresAt time.Time) (*models.EmailChange, error) {
+	change := &models.EmailChange{
+		UUID:      uuid.New(),
+		UserID:    userID,
+		NewEmail:  newEmail,
+		TokenHash: tokenHash,
+		Status:    constants.EmailChangePending,
+		ExpiresAt: expiresAt,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.EmailChange{}).
+			Where("user_id = ? AND status = ?", userID, constants.EmailChangePending).
+			Updates(map[string]any{"status": constants.EmailChangeCancelled, "cancelled_at": time.Now()}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return change, nil
+}
+
+// FindPending mencari permintaan penggantian email user yang masih berlaku, mengembalikan nil jika tidak ada
+func (r *EmailChangeRepository) FindPending(ctx context.Context, userID uint) (*models.EmailChange, error) {
+	var change models.EmailChange
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ? AND expires_at > ?", userID, constants.EmailChangePending, time.Now()).
+		First(&change).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &change, nil
+}
+
+// FindPendingByToken mencari permintaan yang masih berlaku berdasarkan hash token, mengembalikan nil jika tidak ada
+func (r *EmailChangeRepository) FindPendingByToken(ctx context.Context, tokenHash string) (*models.EmailChange, error) {
+	var change models.EmailChange
+	err := r.db.WithContext(ctx).
+		Preload("User.Organization").
+		Where("token_hash = ? AND status = ? AND expires_at > ?", tokenHash, constants.EmailChangePending, time.Now()).
+		First(&change).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &change, nil
+}
+
+// Cancel membatalkan permintaan penggantian email
+func (r *EmailChangeRepository) Cancel(ctx context.Context, change *models.EmailChange) error {
+	now := time.Now()
+	change.Status = constants.EmailChangeCancelled
+	change.CancelledAt = &now
+
+	err := r.db.WithContext(ctx).
+		Model(change).
+		Select("Status", "CancelledAt").
+		Updates(change).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// Confirm mengganti email user dengan alamat baru yang sudah terverifikasi dalam satu transaksi
+func (r *EmailChangeRepository) Confirm(ctx context.Context, change *models.EmailChange) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// status pending ikut dicek agar token yang sama tidak bisa dipakai dua kali bersamaan
+		result := tx.Model(change).
+			Where("status = ?", constants.EmailChangePending).
+			Updates(map[string]any{"status": constants.EmailChangeConfirmed, "confirmed_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrEmailChangeInvalid
+		}
+		return tx.Model(&models.User{}).
+			Where("id = ?", change.UserID).
+			Updates(map[string]any{
+				"email":             change.NewEmail,
+				"email_verified_at": now,
+				"version":           gorm.Expr("version + 1"),
+			}).Error
+	})
+	if err != nil {
+		if errors.Is(err, errConstant.ErrEmailChangeInvalid) {
+			return errWrap.WrapError(err)
+		}
+		// email baru sempat dipakai user lain sejak permintaan dibuat
+		if constraint, ok := errWrap.UniqueViolation(err); ok && constraint == constants.UsersEmailUniqueIndex {
+			return errWrap.WrapError(errConstant.ErrEmailExist)
+		}
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	change.Status = constants.EmailChangeConfirmed
+	change.ConfirmedAt = &now
+	return nil
+}
@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type GroupRepository struct {
+	db *gorm.DB
+}
+
+type IGroupRepository interface {
+	Create(context.Context, *dto.GroupRequest) (*models.Group, error)
+	Update(context.Context, *dto.GroupRequest, string) (*models.Group, error)
+	Delete(context.Context, string) error
+	FindAll(context.Context) ([]models.Group, error)
+	FindByUUID(context.Context, string) (*models.Group, error)
+	FindMembers(context.Context, uint) ([]models.GroupMember, error)
+	FindUUIDsByUser(context.Context, uuid.UUID) ([]uuid.UUID, error)
+	AddMember(context.Context, uint, uint) error
+	DeleteMember(context.Context, uint, uint) error
+}
+
+func NewGroupRepository(db *gorm.DB) IGroupRepository {
+	return &GroupRepository{db: db}
+}
+
+// scopeTenant membatasi query pada grup milik tenant aktif, atau grup platform jika tanpa tenant
+func scopeTenant(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID)
+		if !ok {
+			return db.Where("user_groups.organization_id IS NULL")
+		}
+		return db.Where("user_groups.organization_id = (SELECT id FROM organizations WHERE uuid = ?)", tenant)
+	}
+}
+
+// Create menambahkan grup baru pada tenant aktif
+func (r *GroupRepository) Create(ctx context.Context, req *dto.GroupRequest) (*models.Group, error) {
+	group := &models.Group{
+		UUID:        uuid.New(),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID); ok {
+		var organization models.Organization
+		err := r.db.WithContext(ctx).Where("uuid = ?", tenant).First(&organization).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errWrap.WrapError(errConstant.ErrOrganizationNotFound)
+			}
+			return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		group.OrganizationID = &organization.ID
+	}
+
+	err := r.db.WithContext(ctx).Create(group).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return group, nil
+}
+
+// Update mengubah data grup berdasarkan UUID
+func (r *GroupRepository) Update(ctx context.Context, req *dto.GroupRequest, uuid string) (*models.Group, error) {
+	group, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(group).
+		Select("Name", "Description").
+		Updates(models.Group{Name: req.Name, Description: req.Description}).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return group, nil
+}
+
+// Delete menghapus grup beserta keanggotaannya
+func (r *GroupRepository) Delete(ctx context.Context, uuid string) error {
+	group, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", group.ID).Delete(&models.GroupMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(group).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindAll mengambil seluruh grup pada tenant aktif
+func (r *GroupRepository) FindAll(ctx context.Context) ([]models.Group, error) {
+	var groups []models.Group
+	err := r.db.WithContext(ctx).
+		Scopes(scopeTenant(ctx)).
+		Order("name").
+		Find(&groups).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return groups, nil
+}
+
+// FindByUUID mencari grup berdasarkan UUID, error jika tidak ditemukan
+func (r *GroupRepository) FindByUUID(ctx context.Context, uuid string) (*models.Group, error) {
+	var group models.Group
+	err := r.db.WithContext(ctx).
+		Scopes(scopeTenant(ctx)).
+		Where("uuid = ?", uuid).
+		First(&group).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrGroupNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &group, nil
+}
+
+// FindMembers mengambil seluruh anggota grup
+func (r *GroupRepository) FindMembers(ctx context.Context, groupID uint) ([]models.GroupMember, error) {
+	var members []models.GroupMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("group_id = ?", groupID).
+		Order("created_at").
+		Find(&members).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return members, nil
+}
+
+// FindUUIDsByUser mengambil UUID seluruh grup yang diikuti user pada tenant aktif
+func (r *GroupRepository) FindUUIDsByUser(ctx context.Context, userUUID uuid.UUID) ([]uuid.UUID, error) {
+	var uuids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&models.Group{}).
+		Scopes(scopeTenant(ctx)).
+		Joins("JOIN group_members gm ON gm.group_id = user_groups.id").
+		Joins("JOIN users u ON u.id = gm.user_id").
+		Where("u.uuid = ?", userUUID).
+		Pluck("user_groups.uuid", &uuids).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return uuids, nil
+}
+
+// AddMember menambahkan user ke grup
+func (r *GroupRepository) AddMember(ctx context.Context, groupID, userID uint) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.GroupMember{}).
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Count(&count).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if count > 0 {
+		return errWrap.WrapError(errConstant.ErrGroupMemberExist)
+	}
+
+	err = r.db.WithContext(ctx).Create(&models.GroupMember{GroupID: groupID, UserID: userID}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// DeleteMember mengeluarkan user dari grup
+func (r *GroupRepository) DeleteMember(ctx context.Context, groupID, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Delete(&models.GroupMember{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errWrap.WrapError(errConstant.ErrGroupMemberNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type InvitationRepository struct {
+	db *gorm.DB
+}
+
+type IInvitationRepository interface {
+	Create(context.Context, *models.Invitation) error
+	FindAll(context.Context, *dto.InvitationFilter) ([]models.Invitation, error)
+	FindByUUID(context.Context, string) (*models.Invitation, error)
+	FindPendingByEmail(context.Context, string) (*models.Invitation, error)
+	FindPendingByToken(context.Context, string) (*models.Invitation, error)
+	Renew(context.Context, *models.Invitation, string, time.Time) error
+	Revoke(context.Context, *models.Invitation) error
+}
+
+func NewInvitationRepository(db *gorm.DB) IInvitationRepository {
+	return &InvitationRepository{db: db}
+}
+
+// scopeTenant membatasi query pada undangan milik tenant aktif, atau undangan platform jika tanpa tenant
+func scopeTenant(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID)
+		if !ok {
+			return db.Where("invitations.organization_id IS NULL")
+		}
+		return db.Where("invitations.organization_id = (SELECT id FROM organizations WHERE uuid = ?)", tenant)
+	}
+}
+
+func preloadInvitation(db *gorm.DB) *gorm.DB {
+	return db.Preload("Role").Preload("InvitedBy").Preload("User")
+}
+
+// Create menyimpan undangan baru
+func (r *InvitationRepository) Create(ctx context.Context, invitation *models.Invitation) error {
+	err := r.db.WithContext(ctx).Create(invitation).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindAll mengambil undangan pada tenant aktif, terbaru lebih dulu
+func (r *InvitationRepository) FindAll(ctx context.Context, filter *dto.InvitationFilter) ([]models.Invitation, error) {
+	query := r.db.WithContext(ctx).Scopes(scopeTenant(ctx), preloadInvitation)
+	now := time.Now()
+	switch filter.Status {
+	case constants.InvitationPending:
+		query = query.Where("status = ? AND expires_at > ?", constants.InvitationPending, now)
+	case constants.InvitationExpired:
+		query = query.Where("status = ? AND expires_at <= ?", constants.InvitationPending, now)
+	case "":
+	default:
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var invitations []models.Invitation
+	err := query.Order("created_at DESC").Find(&invitations).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return invitations, nil
+}
+
+// FindByUUID mencari undangan pada tenant aktif berdasarkan UUID
+func (r *InvitationRepository) FindByUUID(ctx context.Context, uuid string) (*models.Invitation, error) {
+	var invitation models.Invitation
+	err := r.db.WithContext(ctx).
+		Scopes(scopeTenant(ctx), preloadInvitation).
+		Where("uuid = ?", uuid).
+		First(&invitation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrInvitationNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &invitation, nil
+}
+
+// FindPendingByEmail mencari undangan yang masih berlaku untuk email pada tenant aktif,
+// mengembalikan nil jika tidak ada
+func (r *InvitationRepository) FindPendingByEmail(ctx context.Context, email string) (*models.Invitation, error) {
+	var invitation models.Invitation
+	err := r.db.WithContext(ctx).
+		Scopes(scopeTenant(ctx)).
+		Where("LOWER(email) = LOWER(?) AND status = ? AND expires_at > ?", email, constants.InvitationPending, time.Now()).
+		First(&invitation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &invitation, nil
+}
+
+// FindPendingByToken mencari undangan yang masih berlaku berdasarkan hash token dari seluruh
+// tenant, mengembalikan nil jika tidak ada
+func (r *InvitationRepository) FindPendingByToken(ctx context.Context, tokenHash string) (*models.Invitation, error) {
+	var invitation models.Invitation
+	err := r.db.WithContext(ctx).
+		Preload("Role").
+		Preload("Organization").
+		Where("token_hash = ? AND status = ? AND expires_at > ?", tokenHash, constants.InvitationPending, time.Now()).
+		First(&invitation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &invitation, nil
+}
+
+// Renew mengganti token dan masa berlaku undangan yang masih pending; link lama tidak berlaku lagi
+func (r *InvitationRepository) Renew(ctx context.Context, invitation *models.Invitation, tokenHash string, expiresAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(invitation).
+		Where("status = ?", constants.InvitationPending).
+		Updates(map[string]any{"token_hash": tokenHash, "expires_at": expiresAt})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errWrap.WrapError(errConstant.ErrInvitationNotPending)
+	}
+	invitation.TokenHash = tokenHash
+	invitation.ExpiresAt = expiresAt
+	return nil
+}
+
+// Revoke membatalkan undangan yang belum diterima
+func (r *InvitationRepository) Revoke(ctx context.Context, invitation *models.Invitation) error {
+	now := time.Now()
+	result := r.db.WithContext(ctx).
+		Model(invitation).
+		Where("status = ?", constants.InvitationPending).
+		Updates(map[string]any{"status": constants.InvitationRevoked, "revoked_at": now})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errWrap.WrapError(errConstant.ErrInvitationNotPending)
+	}
+	invitation.Status = constants.InvitationRevoked
+	invitation.RevokedAt = &now
+	return nil
+}
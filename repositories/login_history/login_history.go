@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/common/response"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+const defaultLoginHistoryLimit = 20
+
+type LoginHistoryRepository struct {
+	db *gorm.DB
+}
+
+type ILoginHistoryRepository interface {
+	Record(context.Context, *models.LoginAttempt) error
+	FindByUser(context.Context, uint, *dto.LoginHistoryRequest) ([]models.LoginAttempt, *response.Pagination, error)
+	Prune(context.Context, time.Time) (int64, error)
+}
+
+func NewLoginHistoryRepository(db *gorm.DB) ILoginHistoryRepository {
+	return &LoginHistoryRepository{db: db}
+}
+
+// Record menyimpan percobaan login; login yang berhasil sekaligus memperbarui last_login_at user
+func (r *LoginHistoryRepository) Record(ctx context.Context, attempt *models.LoginAttempt) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+		if !attempt.Success || attempt.UserID == nil {
+			return nil
+		}
+		return tx.Model(&models.User{}).
+			Where("id = ?", *attempt.UserID).
+			UpdateColumn("last_login_at", attempt.CreatedAt).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindByUser mengambil riwayat login user, terbaru lebih dulu
+func (r *LoginHistoryRepository) FindByUser(
+	ctx context.Context,
+	userID uint,
+	req *dto.LoginHistoryRequest,
+) ([]models.LoginAttempt, *response.Pagination, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultLoginHistoryLimit
+	}
+	meta := &response.Pagination{Page: req.Page, Limit: limit}
+	if meta.Page == 0 {
+		meta.Page = 1
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&models.LoginAttempt{}).
+		Where("user_id = ?", userID)
+	if req.Success != nil {
+		query = query.Where("success = ?", *req.Success)
+	}
+
+	err := query.Session(&gorm.Session{}).Count(&meta.Total).Error
+	if err != nil {
+		return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	meta.TotalPages = int((meta.Total + int64(limit) - 1) / int64(limit))
+	meta.HasNext = meta.Page < meta.TotalPages
+
+	var attempts []models.LoginAttempt
+	err = query.
+		Order("created_at DESC, id DESC").
+		Offset((meta.Page - 1) * limit).
+		Limit(limit).
+		Find(&attempts).Error
+	if err != nil {
+		return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return attempts, meta, nil
+}
+
+// Prune menghapus riwayat login yang lebih lama dari before dan mengembalikan jumlah baris terhapus
+func (r *LoginHistoryRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&models.LoginAttempt{})
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrganizationRepository struct {
+	db *gorm.DB
+}
+
+type IOrganizationRepository interface {
+	Create(context.Context, *dto.OrganizationRequest) (*models.Organization, error)
+	Update(context.Context, *dto.OrganizationRequest, string) (*models.Organization, error)
+	FindAll(context.Context) ([]models.Organization, error)
+	FindByUUID(context.Context, string) (*models.Organization, error)
+	FindByCode(context.Context, string) (*models.Organization, error)
+	FindMembers(context.Context, uint) ([]models.OrganizationMember, error)
+	ReplaceMember(context.Context, uint, uint, []uint) error
+	DeleteMember(context.Context, uint, uint) error
+}
+
+func NewOrganizationRepository(db *gorm.DB) IOrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+// Create menambahkan organisasi baru ke database
+func (r *OrganizationRepository) Create(ctx context.Context, req *dto.OrganizationRequest) (*models.Organization, error) {
+	organization := &models.Organization{
+		UUID: uuid.New(),
+		Code: req.Code,
+		Name: req.Name,
+	}
+
+	err := r.db.WithContext(ctx).Create(organization).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return organization, nil
+}
+
+// Update mengubah data organisasi berdasarkan UUID
+func (r *OrganizationRepository) Update(ctx context.Context, req *dto.OrganizationRequest, uuid string) (*models.Organization, error) {
+	organization, err := r.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(organization).
+		Updates(models.Organization{Code: req.Code, Name: req.Name}).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return organization, nil
+}
+
+// FindAll mengambil seluruh organisasi
+func (r *OrganizationRepository) FindAll(ctx context.Context) ([]models.Organization, error) {
+	var organizations []models.Organization
+	err := r.db.WithContext(ctx).Order("name").Find(&organizations).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return organizations, nil
+}
+
+// FindByUUID mencari organisasi berdasarkan UUID, error jika tidak ditemukan
+func (r *OrganizationRepository) FindByUUID(ctx context.Context, uuid string) (*models.Organization, error) {
+	var organization models.Organization
+	err := r.db.WithContext(ctx).
+		Where("uuid = ?", uuid).
+		First(&organization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrOrganizationNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &organization, nil
+}
+
+// FindByCode mencari organisasi berdasarkan kode, mengembalikan nil jika tidak ditemukan
+func (r *OrganizationRepository) FindByCode(ctx context.Context, code string) (*models.Organization, error) {
+	var organization models.Organization
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		First(&organization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &organization, nil
+}
+
+// FindMembers mengambil seluruh keanggotaan organisasi beserta user dan role-nya
+func (r *OrganizationRepository) FindMembers(ctx context.Context, organizationID uint) ([]models.OrganizationMember, error) {
+	var members []models.OrganizationMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("Role").
+		Where("organization_id = ?", organizationID).
+		Order("user_id").
+		Find(&members).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return members, nil
+}
+
+// ReplaceMember mengganti seluruh role user pada organisasi dalam satu transaksi
+func (r *OrganizationRepository) ReplaceMember(ctx context.Context, organizationID, userID uint, roleIDs []uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("organization_id = ? AND user_id = ?", organizationID, userID).
+			Delete(&models.OrganizationMember{}).Error
+		if err != nil {
+			return err
+		}
+		members := make([]models.OrganizationMember, 0, len(roleIDs))
+		for _, roleID := range roleIDs {
+			members = append(members, models.OrganizationMember{
+				OrganizationID: organizationID,
+				UserID:         userID,
+				RoleID:         roleID,
+			})
+		}
+		return tx.Create(&members).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// DeleteMember mengeluarkan user dari organisasi
+func (r *OrganizationRepository) DeleteMember(ctx context.Context, organizationID, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("organization_id = ? AND user_id = ?", organizationID, userID).
+		Delete(&models.OrganizationMember{})
+	if result.Error != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return errWrap.WrapError(errConstant.ErrMemberNotFound)
+	}
+	return nil
+}
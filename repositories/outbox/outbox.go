@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"time"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+type IOutboxRepository interface {
+	Enqueue(context.Context, *models.OutboxEvent) error
+	FindPending(context.Context, time.Time, int) ([]models.OutboxEvent, error)
+	MarkDelivered(context.Context, *models.OutboxEvent) error
+	MarkFailed(context.Context, *models.OutboxEvent, string, time.Time) error
+}
+
+func NewOutboxRepository(db *gorm.DB) IOutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Enqueue menyimpan event agar dikirim job outbox; dipanggil di dalam transaksi perubahan datanya
+func (r *OutboxRepository) Enqueue(ctx context.Context, event *models.OutboxEvent) error {
+	if event.NextAttemptAt.IsZero() {
+		event.NextAttemptAt = time.Now()
+	}
+	err := r.db.WithContext(ctx).Create(event).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindPending mengambil event yang belum terkirim dan sudah waktunya dicoba, terlama lebih dulu
+func (r *OutboxRepository) FindPending(ctx context.Context, now time.Time, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	err := r.db.WithContext(ctx).
+		Where("delivered_at IS NULL AND next_attempt_at <= ?", now).
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, event *models.OutboxEvent) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(event).
+		Updates(map[string]any{
+			"attempts":     event.Attempts + 1,
+			"delivered_at": now,
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// MarkFailed mencatat percobaan yang gagal dan menjadwalkan percobaan berikutnya
+func (r *OutboxRepository) MarkFailed(ctx context.Context, event *models.OutboxEvent, reason string, nextAttemptAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(event).
+		Updates(map[string]any{
+			"attempts":        event.Attempts + 1,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      reason,
+		}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
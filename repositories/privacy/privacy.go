@@ -0,0 +1,242 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PrivacyRepository struct {
+	db *gorm.DB
+}
+
+// UserData adalah seluruh data yang disimpan tentang satu user, lintas tenant
+type UserData struct {
+	User          models.User
+	Memberships   []models.OrganizationMember
+	Groups        []models.Group
+	Elevations    []models.RoleElevation
+	StatusHistory []models.UserStatusHistory
+	AuditLogs     []models.AuditLog
+	Erasures      []models.ErasureRequest
+	EmailChanges  []models.EmailChange
+	LoginAttempts []models.LoginAttempt
+	Invitations   []models.Invitation
+}
+
+type IPrivacyRepository interface {
+	FindUserData(context.Context, uint) (*UserData, error)
+	CreateErasure(context.Context, uint, time.Time) (*models.ErasureRequest, error)
+	FindPendingErasure(context.Context, uint) (*models.ErasureRequest, error)
+	CancelErasure(context.Context, *models.ErasureRequest) error
+	FindDueErasures(context.Context, time.Time) ([]models.ErasureRequest, error)
+	Erase(context.Context, *models.ErasureRequest) error
+}
+
+func NewPrivacyRepository(db *gorm.DB) IPrivacyRepository {
+	return &PrivacyRepository{db: db}
+}
+
+// FindUserData mengumpulkan seluruh data milik user untuk keperluan ekspor
+func (r *PrivacyRepository) FindUserData(ctx context.Context, userID uint) (*UserData, error) {
+	data := &UserData{}
+	db := r.db.WithContext(ctx)
+
+	err := db.Preload("UserRoles.Role").Preload("Organization").First(&data.User, userID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrUserNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	queries := []*gorm.DB{
+		db.Preload("Organization").Preload("Role").Where("user_id = ?", userID).Find(&data.Memberships),
+		db.Where("id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID).Find(&data.Groups),
+		db.Preload("Role").Where("user_id = ?", userID).Order("created_at").Find(&data.Elevations),
+		db.Where("user_id = ?", userID).Order("created_at").Find(&data.StatusHistory),
+		db.Where("actor_uuid = ? OR subject_uuid = ?", data.User.UUID, data.User.UUID).Order("created_at").Find(&data.AuditLogs),
+		db.Where("user_id = ?", userID).Order("created_at").Find(&data.Erasures),
+		db.Where("user_id = ?", userID).Order("created_at").Find(&data.EmailChanges),
+		db.Where("user_id = ?", userID).Order("created_at").Find(&data.LoginAttempts),
+		db.Preload("Role").Preload("Organization").
+			Scopes(scopeInvitations(&data.User)).Order("created_at").Find(&data.Invitations),
+	}
+	for _, query := range queries {
+		if query.Error != nil {
+			return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		}
+	}
+	return data, nil
+}
+
+// CreateErasure menjadwalkan penghapusan data user pada scheduledAt
+func (r *PrivacyRepository) CreateErasure(ctx context.Context, userID uint, scheduledAt time.Time) (*models.ErasureRequest, error) {
+	request := &models.ErasureRequest{
+		UUID:        uuid.New(),
+		UserID:      userID,
+		Status:      constants.ErasurePending,
+		ScheduledAt: scheduledAt,
+	}
+
+	err := r.db.WithContext(ctx).Create(request).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return request, nil
+}
+
+// FindPendingErasure mencari permintaan penghapusan yang masih menunggu, mengembalikan nil jika tidak ada
+func (r *PrivacyRepository) FindPendingErasure(ctx context.Context, userID uint) (*models.ErasureRequest, error) {
+	var request models.ErasureRequest
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, constants.ErasurePending).
+		First(&request).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &request, nil
+}
+
+// CancelErasure membatalkan permintaan penghapusan selama masa tenggang
+func (r *PrivacyRepository) CancelErasure(ctx context.Context, request *models.ErasureRequest) error {
+	now := time.Now()
+	request.Status = constants.ErasureCancelled
+	request.CancelledAt = &now
+
+	err := r.db.WithContext(ctx).
+		Model(request).
+		Select("Status", "CancelledAt").
+		Updates(request).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindDueErasures mengambil permintaan penghapusan yang masa tenggangnya sudah habis
+func (r *PrivacyRepository) FindDueErasures(ctx context.Context, now time.Time) ([]models.ErasureRequest, error) {
+	var requests []models.ErasureRequest
+	err := r.db.WithContext(ctx).
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Where("status = ? AND scheduled_at <= ?", constants.ErasurePending, now).
+		Find(&requests).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return requests, nil
+}
+
+// scopeInvitations memilih undangan milik user: undangan yang diterimanya, atau undangan
+// yang dikirim ke alamat email-nya
+func scopeInvitations(user *models.User) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ? OR LOWER(email) = ?", user.ID, strings.ToLower(user.Email))
+	}
+}
+
+// Erase menganonimkan data pribadi user secara permanen. Baris user tetap ada
+// agar relasi dari tabel lain tetap valid, namun tidak lagi bisa dipakai login.
+func (r *PrivacyRepository) Erase(ctx context.Context, request *models.ErasureRequest) error {
+	now := time.Now()
+	user := request.User
+	alias := "erased_" + strings.ReplaceAll(user.UUID.String(), "-", "")[:12]
+	email := user.UUID.String() + "@erased.invalid"
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.User{}).
+			Unscoped().
+			Where("id = ?", user.ID).
+			Updates(map[string]any{
+				"name":              "Erased User",
+				"username":          alias,
+				"email":             email,
+				"phone_number":      "",
+				"password":          "!",
+				"email_verified_at": nil,
+				"status":            constants.UserDeleted,
+				"suspended_reason":  "",
+				"suspended_until":   nil,
+				"avatar_key":        "",
+				"attributes":        nil,
+				"last_login_at":     nil,
+				"deleted_at":        now,
+				"version":           gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, model := range []any{&models.UserRole{}, &models.OrganizationMember{}, &models.GroupMember{}, &models.EmailChange{}, &models.LoginAttempt{}} {
+			if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		err = tx.Model(&models.UserStatusHistory{}).
+			Where("user_id = ?", user.ID).
+			Update("reason", constants.ErasedValue).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Create(&models.UserStatusHistory{
+			UserID:     user.ID,
+			FromStatus: user.Status,
+			ToStatus:   constants.UserDeleted,
+			Reason:     constants.ErasedValue,
+		}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&models.RoleElevation{}).
+			Where("user_id = ?", user.ID).
+			Update("reason", constants.ErasedValue).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&models.AccessReviewItem{}).
+			Where("user_id = ?", user.ID).
+			Updates(map[string]any{
+				"username": alias,
+				"email":    email,
+				"reason":   gorm.Expr("CASE WHEN COALESCE(reason, '') = '' THEN reason ELSE ? END", constants.ErasedValue),
+				"note":     gorm.Expr("CASE WHEN COALESCE(note, '') = '' THEN note ELSE ? END", constants.ErasedValue),
+			}).Error
+		if err != nil {
+			return err
+		}
+		// undangan yang belum diterima ikut dicabut karena alamat tujuannya sudah dihapus
+		err = tx.Model(&models.Invitation{}).
+			Scopes(scopeInvitations(&user)).
+			Updates(map[string]any{
+				"email":      email,
+				"name":       "Erased User",
+				"status":     gorm.Expr("CASE WHEN status = ? THEN ? ELSE status END", constants.InvitationPending, constants.InvitationRevoked),
+				"revoked_at": gorm.Expr("CASE WHEN status = ? THEN ? ELSE revoked_at END", constants.InvitationPending, now),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		request.Status = constants.ErasureCompleted
+		request.CompletedAt = &now
+		return tx.Model(request).Select("Status", "CompletedAt").Updates(request).Error
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
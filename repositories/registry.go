@@ -1,6 +1,21 @@
 package repositories
 
 import (
+	"context"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	accessReviewRepo "user-service/repositories/access_review"
+	attributeRepo "user-service/repositories/attribute"
+	auditRepo "user-service/repositories/audit"
+	elevationRepo "user-service/repositories/elevation"
+	emailChangeRepo "user-service/repositories/email_change"
+	groupRepo "user-service/repositories/group"
+	invitationRepo "user-service/repositories/invitation"
+	loginHistoryRepo "user-service/repositories/login_history"
+	orgRepo "user-service/repositories/organization"
+	outboxRepo "user-service/repositories/outbox"
+	privacyRepo "user-service/repositories/privacy"
+	roleRepo "user-service/repositories/role"
 	repositories "user-service/repositories/user"
 
 	"gorm.io/gorm"
@@ -12,6 +27,19 @@ type Regsitry struct {
 
 type IRepositoryRegistry interface {
 	GetUser() repositories.IUserRepository
+	GetRole() roleRepo.IRoleRepository
+	GetOrganization() orgRepo.IOrganizationRepository
+	GetGroup() groupRepo.IGroupRepository
+	GetAudit() auditRepo.IAuditRepository
+	GetElevation() elevationRepo.IElevationRepository
+	GetAccessReview() accessReviewRepo.IAccessReviewRepository
+	GetPrivacy() privacyRepo.IPrivacyRepository
+	GetAttribute() attributeRepo.IAttributeRepository
+	GetEmailChange() emailChangeRepo.IEmailChangeRepository
+	GetInvitation() invitationRepo.IInvitationRepository
+	GetLoginHistory() loginHistoryRepo.ILoginHistoryRepository
+	GetOutbox() outboxRepo.IOutboxRepository
+	Transaction(context.Context, func(IRepositoryRegistry) error) error
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
@@ -20,4 +48,70 @@ func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
 
 func (r *Regsitry) GetUser() repositories.IUserRepository {
 	return repositories.NewUserRepository(r.db)
-}
\ No newline at end of file
+}
+
+func (r *Regsitry) GetRole() roleRepo.IRoleRepository {
+	return roleRepo.NewRoleRepository(r.db)
+}
+
+func (r *Regsitry) GetOrganization() orgRepo.IOrganizationRepository {
+	return orgRepo.NewOrganizationRepository(r.db)
+}
+
+func (r *Regsitry) GetGroup() groupRepo.IGroupRepository {
+	return groupRepo.NewGroupRepository(r.db)
+}
+
+func (r *Regsitry) GetAudit() auditRepo.IAuditRepository {
+	return auditRepo.NewAuditRepository(r.db)
+}
+
+func (r *Regsitry) GetElevation() elevationRepo.IElevationRepository {
+	return elevationRepo.NewElevationRepository(r.db)
+}
+
+func (r *Regsitry) GetAccessReview() accessReviewRepo.IAccessReviewRepository {
+	return accessReviewRepo.NewAccessReviewRepository(r.db)
+}
+
+func (r *Regsitry) GetPrivacy() privacyRepo.IPrivacyRepository {
+	return privacyRepo.NewPrivacyRepository(r.db)
+}
+
+func (r *Regsitry) GetAttribute() attributeRepo.IAttributeRepository {
+	return attributeRepo.NewAttributeRepository(r.db)
+}
+
+func (r *Regsitry) GetEmailChange() emailChangeRepo.IEmailChangeRepository {
+	return emailChangeRepo.NewEmailChangeRepository(r.db)
+}
+
+func (r *Regsitry) GetInvitation() invitationRepo.IInvitationRepository {
+	return invitationRepo.NewInvitationRepository(r.db)
+}
+
+func (r *Regsitry) GetLoginHistory() loginHistoryRepo.ILoginHistoryRepository {
+	return loginHistoryRepo.NewLoginHistoryRepository(r.db)
+}
+
+func (r *Regsitry) GetOutbox() outboxRepo.IOutboxRepository {
+	return outboxRepo.NewOutboxRepository(r.db)
+}
+
+// Transaction menjalankan fn dengan registry yang seluruh repository-nya memakai satu
+// transaksi, sehingga perubahan dari beberapa repository tersimpan atau batal bersama.
+// Error dari fn dikembalikan apa adanya.
+func (r *Regsitry) Transaction(ctx context.Context, fn func(IRepositoryRegistry) error) error {
+	var fnErr error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fnErr = fn(NewRepositoryRegistry(tx))
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
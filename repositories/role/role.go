@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"slices"
+	"strings"
+	errWrap "user-service/common/error"
+	errConstant "user-service/constants/error"
+	"user-service/domain/models"
+
+	"gorm.io/gorm"
+)
+
+type RoleRepository struct {
+	db *gorm.DB
+}
+
+type IRoleRepository interface {
+	FindByCodes(context.Context, []string) ([]models.Role, error)
+}
+
+func NewRoleRepository(db *gorm.DB) IRoleRepository {
+	return &RoleRepository{db: db}
+}
+
+// FindByCodes mencari role berdasarkan kode, error jika ada kode yang tidak dikenal
+func (r *RoleRepository) FindByCodes(ctx context.Context, codes []string) ([]models.Role, error) {
+	upperCodes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		upperCodes = append(upperCodes, strings.ToUpper(code))
+	}
+	slices.Sort(upperCodes)
+	upperCodes = slices.Compact(upperCodes)
+
+	var roles []models.Role
+	err := r.db.WithContext(ctx).
+		Where("code IN ?", upperCodes).
+		Find(&roles).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if len(roles) != len(upperCodes) {
+		return nil, errWrap.WrapError(errConstant.ErrRoleNotFound)
+	}
+	return roles, nil
+}
@@ -2,15 +2,24 @@ package repositories
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 	errWrap "user-service/common/error"
+	"user-service/common/response"
+	"user-service/constants"
 	errConstant "user-service/constants/error"
 	"user-service/domain/dto"
 	"user-service/domain/models"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserRepository struct {
@@ -19,35 +28,75 @@ type UserRepository struct {
 
 type IUserRepository interface {
 	Register(context.Context, *dto.RegisterRequest) (*models.User, error)
+	RegisterBatch(context.Context, []dto.RegisterRequest) ([]models.User, error)
+	RegisterInvited(context.Context, *dto.RegisterRequest, *models.Invitation) (*models.User, error)
+	FindExisting(context.Context, []string, []string) ([]models.User, error)
+	CheckTenantConflict(context.Context, *models.User, ...uint) error
 	Update(context.Context, *dto.UpdateUserRequest, string) (*models.User, error)
+	Patch(context.Context, *models.User, []string) error
 	FindByUsername(context.Context, string) (*models.User, error)
 	FindByEmail(context.Context, string) (*models.User, error)
 	FindByUUID(context.Context, string) (*models.User, error)
+	FindAll(context.Context, *dto.UserListRequest) ([]models.User, *response.Pagination, error)
+	FindInBatches(context.Context, *dto.UserListRequest, int, func([]models.User) error) error
+	FindStatusByUUID(context.Context, uuid.UUID) (*models.User, error)
+	FindExpiredSuspensions(context.Context, time.Time) ([]models.User, error)
+	FindStatusHistory(context.Context, uint) ([]models.UserStatusHistory, error)
+	ChangeStatus(context.Context, *models.User, *dto.UserStatusRequest, *uint) error
+	UpdateAvatar(context.Context, *models.User, string) error
+	RevokeTokens(context.Context, *models.User) error
 }
 
 func NewUserRepository(db *gorm.DB) IUserRepository {
 	return &UserRepository{db: db}
 }
 
+// scopeTenant membatasi query pada user milik tenant aktif atau anggota tenant tersebut.
+// Tanpa tenant, query dibatasi pada user platform (tanpa organisasi).
+func scopeTenant(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID)
+		if !ok {
+			return db.Where("users.organization_id IS NULL")
+		}
+		return db.Where(`(users.organization_id = (SELECT id FROM organizations WHERE uuid = ?)
+			OR users.id IN (SELECT om.user_id FROM organization_members om
+				JOIN organizations o ON o.id = om.organization_id WHERE o.uuid = ?))`, tenant, tenant)
+	}
+}
+
+// preloadRoles memuat role global user yang sedang berlaku dan role user pada tenant aktif
+func preloadRoles(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		now := time.Now()
+		db = db.
+			Preload("UserRoles", "(starts_at IS NULL OR starts_at <= ?) AND (expires_at IS NULL OR expires_at > ?)", now, now).
+			Preload("UserRoles.Role")
+		tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID)
+		if !ok {
+			return db
+		}
+		return db.
+			Preload("Memberships", "organization_id = (SELECT id FROM organizations WHERE uuid = ?)", tenant).
+			Preload("Memberships.Role")
+	}
+}
+
 // Register menambahkan user baru ke database
 func (r *UserRepository) Register(ctx context.Context, req *dto.RegisterRequest) (*models.User, error) {
-	user := &models.User{
-		UUID:        uuid.New(),
-		Name:        req.Name,
-		Username:    req.Username,
-		Password:    req.Password,
-		PhoneNumber: req.PhoneNumber,
-		Email:       req.Email,
-		RoleID:      req.RoleID,
+	organization, err := r.tenantOrganization(ctx)
+	if err != nil {
+		return nil, err
 	}
+	user := newUser(req, organization)
 
-	err := r.db.WithContext(ctx).Create(&user).Error
+	err = r.db.WithContext(ctx).Create(&user).Error
 	if err != nil {
-		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, uniqueError(err)
 	}
 
 	if err := r.db.WithContext(ctx).
-		Preload("Role").
+		Scopes(preloadRoles(ctx)).
 		First(&user, "uuid = ?", user.UUID).Error; err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -55,30 +104,253 @@ func (r *UserRepository) Register(ctx context.Context, req *dto.RegisterRequest)
 	return user, nil
 }
 
+// RegisterInvited membuat user dari undangan dan menandai undangan diterima dalam satu transaksi.
+// User masuk ke organisasi undangan dengan role undangan, dan email-nya dianggap terverifikasi.
+func (r *UserRepository) RegisterInvited(ctx context.Context, req *dto.RegisterRequest, invitation *models.Invitation) (*models.User, error) {
+	now := time.Now()
+	register := *req
+	register.RoleIDs = []uint{invitation.RoleID}
+	user := newUser(&register, invitation.Organization)
+	user.EmailVerifiedAt = &now
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		// status pending ikut dicek agar token yang sama tidak bisa dipakai dua kali bersamaan
+		result := tx.Model(invitation).
+			Where("status = ? AND expires_at > ?", constants.InvitationPending, now).
+			Updates(map[string]any{"status": constants.InvitationAccepted, "accepted_at": now, "user_id": user.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errConstant.ErrInvitationInvalid
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errConstant.ErrInvitationInvalid) {
+			return nil, errWrap.WrapError(err)
+		}
+		return nil, uniqueError(err)
+	}
+	invitation.Status = constants.InvitationAccepted
+	invitation.AcceptedAt = &now
+	invitation.UserID = &user.ID
+	return user, nil
+}
+
+// RegisterBatch membuat banyak user sekaligus dalam satu transaksi
+func (r *UserRepository) RegisterBatch(ctx context.Context, reqs []dto.RegisterRequest) ([]models.User, error) {
+	organization, err := r.tenantOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]models.User, 0, len(reqs))
+	for i := range reqs {
+		users = append(users, *newUser(&reqs[i], organization))
+	}
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(users, 100).Error
+	})
+	if err != nil {
+		return nil, uniqueError(err)
+	}
+	return users, nil
+}
+
+// uniqueError menerjemahkan pelanggaran unique index username atau email menjadi error
+// yang dikenali client; error lain dilaporkan sebagai ErrSQLError
+func uniqueError(err error) error {
+	constraint, ok := errWrap.UniqueViolation(err)
+	switch {
+	case ok && constraint == constants.UsersUsernameUniqueIndex:
+		return errWrap.WrapError(errConstant.ErrUsernameExist)
+	case ok && constraint == constants.UsersEmailUniqueIndex:
+		return errWrap.WrapError(errConstant.ErrEmailExist)
+	default:
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+}
+
+// tenantOrganization mengambil organisasi tenant aktif, nil jika request tanpa tenant
+func (r *UserRepository) tenantOrganization(ctx context.Context) (*models.Organization, error) {
+	tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID)
+	if !ok {
+		return nil, nil
+	}
+	var organization models.Organization
+	err := r.db.WithContext(ctx).Where("uuid = ?", tenant).First(&organization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrOrganizationNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &organization, nil
+}
+
+// newUser membentuk model user baru. User yang mendaftar di sebuah tenant
+// mendapat role sebagai anggota tenant tersebut.
+func newUser(req *dto.RegisterRequest, organization *models.Organization) *models.User {
+	user := &models.User{
+		UUID:        uuid.New(),
+		Name:        req.Name,
+		Username:    req.Username,
+		Password:    req.Password,
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		Status:      constants.UserActive,
+		Attributes:  req.Attributes,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+		Version:     1,
+	}
+
+	if organization != nil {
+		user.OrganizationID = &organization.ID
+		for _, roleID := range req.RoleIDs {
+			user.Memberships = append(user.Memberships, models.OrganizationMember{
+				OrganizationID: organization.ID,
+				RoleID:         roleID,
+			})
+		}
+	} else {
+		for _, roleID := range req.RoleIDs {
+			user.UserRoles = append(user.UserRoles, models.UserRole{RoleID: roleID})
+		}
+	}
+	return user
+}
+
 // Update mengubah data user berdasarkan UUID
 func (r *UserRepository) Update(ctx context.Context, req *dto.UpdateUserRequest, uuid string) (*models.User, error) {
 	user := models.User{
 		Name:        req.Name,
 		Username:    req.Username,
-		Password:    *req.Password,
 		PhoneNumber: req.PhoneNumber,
 		Email:       req.Email,
+		Attributes:  req.Attributes,
+		Locale:      req.Locale,
+		Timezone:    req.Timezone,
+	}
+	// password hanya ikut diubah jika dikirim
+	if req.Password != nil {
+		user.Password = *req.Password
+	}
+
+	query := r.db.WithContext(ctx).
+		Scopes(scopeTenant(ctx)).
+		Where("uuid = ?", uuid)
+	// update bersyarat pada versi dalam satu statement agar perubahan bersamaan tidak saling menimpa
+	if req.Version != nil {
+		user.Version = *req.Version + 1
+		query = query.Where("version = ?", *req.Version)
+	}
+	result := query.Updates(&user)
+	if result.Error != nil {
+		return nil, uniqueError(result.Error)
+	}
+	if req.Version != nil && result.RowsAffected == 0 {
+		return nil, errWrap.WrapError(errConstant.ErrVersionConflict)
+	}
+	return &user, nil
+}
+
+// Patch menyimpan hanya kolom yang disebutkan, termasuk nilai kosong pada kolom tersebut.
+// Update hanya berhasil jika versi di database masih sama dengan user.Version.
+func (r *UserRepository) Patch(ctx context.Context, user *models.User, fields []string) error {
+	if len(fields) == 0 {
+		return nil
 	}
+	version := user.Version
+	user.Version++
+	result := r.db.WithContext(ctx).
+		Model(user).
+		Where("version = ?", version).
+		Select(append(fields, "Version")).
+		Updates(user)
+	if result.Error != nil {
+		user.Version = version
+		return uniqueError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		user.Version = version
+		return errWrap.WrapError(errConstant.ErrVersionConflict)
+	}
+	return nil
+}
 
+// FindExisting mengambil user pada tenant aktif yang username atau email-nya sudah dipakai
+func (r *UserRepository) FindExisting(ctx context.Context, usernames, emails []string) ([]models.User, error) {
+	var users []models.User
+	if len(usernames) == 0 && len(emails) == 0 {
+		return users, nil
+	}
 	err := r.db.WithContext(ctx).
-		Where("uuid = ?", uuid).
-		Updates(&user).Error
+		Select("users.id", "users.username", "users.email").
+		Scopes(scopeTenant(ctx)).
+		Where("(LOWER(users.username) IN ? OR LOWER(users.email) IN ?)", usernames, emails).
+		Find(&users).Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
-	return &user, nil
+	return users, nil
+}
+
+// CheckTenantConflict memastikan username dan email user tidak dipakai user lain pada tenant
+// yang bisa dimasuki user tersebut: organisasinya sendiri, organisasi tempat ia menjadi
+// anggota, dan organizationIDs. Unique index hanya menjaga user milik organisasi yang sama,
+// sedangkan lookup ber-tenant (termasuk login) juga mencari di antara anggota tenant.
+func (r *UserRepository) CheckTenantConflict(ctx context.Context, user *models.User, organizationIDs ...uint) error {
+	db := r.db.WithContext(ctx)
+	if user.OrganizationID != nil {
+		organizationIDs = append(organizationIDs, *user.OrganizationID)
+	}
+	if user.ID != 0 {
+		var memberships []uint
+		err := db.Model(&models.OrganizationMember{}).
+			Where("user_id = ?", user.ID).
+			Distinct().
+			Pluck("organization_id", &memberships).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		organizationIDs = append(organizationIDs, memberships...)
+	}
+	if len(organizationIDs) == 0 {
+		return nil
+	}
+
+	var users []models.User
+	err := db.
+		Select("users.id", "users.username", "users.email").
+		Where("users.id <> ? AND (LOWER(users.username) = ? OR LOWER(users.email) = ?)",
+			user.ID, strings.ToLower(user.Username), strings.ToLower(user.Email)).
+		Where(`(users.organization_id IN ?
+			OR users.id IN (SELECT user_id FROM organization_members WHERE organization_id IN ?))`, organizationIDs, organizationIDs).
+		Find(&users).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	for _, other := range users {
+		if strings.EqualFold(other.Username, user.Username) {
+			return errWrap.WrapError(errConstant.ErrUsernameExist)
+		}
+	}
+	if len(users) > 0 {
+		return errWrap.WrapError(errConstant.ErrEmailExist)
+	}
+	return nil
 }
 
 // FindByUsername mencari user berdasarkan username, mengembalikan nil jika tidak ditemukan
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 	err := r.db.WithContext(ctx).
-		Preload("Role").
+		Scopes(scopeTenant(ctx), preloadRoles(ctx)).
 		Where("LOWER(username) = ?", strings.ToLower(username)).
 		First(&user).Error
 
@@ -95,7 +367,7 @@ func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 	err := r.db.WithContext(ctx).
-		Preload("Role").
+		Scopes(scopeTenant(ctx), preloadRoles(ctx)).
 		Where("LOWER(email) = ?", strings.ToLower(email)).
 		First(&user).Error
 
@@ -112,7 +384,7 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*models
 func (r *UserRepository) FindByUUID(ctx context.Context, uuid string) (*models.User, error) {
 	var user models.User
 	err := r.db.WithContext(ctx).
-		Preload("Role").
+		Scopes(scopeTenant(ctx), preloadRoles(ctx)).
 		Where("uuid = ?", uuid).
 		First(&user).Error
 
@@ -124,3 +396,324 @@ func (r *UserRepository) FindByUUID(ctx context.Context, uuid string) (*models.U
 	}
 	return &user, nil
 }
+
+const (
+	defaultListLimit = 10
+	defaultListSort  = "-createdAt"
+)
+
+// sortColumns memetakan field sort yang diizinkan ke kolom tabel users
+var sortColumns = map[string]string{
+	"name":      "users.name",
+	"username":  "users.username",
+	"email":     "users.email",
+	"createdAt": "users.created_at",
+}
+
+// userCursor menyimpan posisi terakhir pagination berbasis cursor: nilai kolom sort dan ID
+type userCursor struct {
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+func encodeCursor(user *models.User, field string) string {
+	cursor := userCursor{ID: user.ID}
+	switch field {
+	case "name":
+		cursor.Value = user.Name
+	case "username":
+		cursor.Value = user.Username
+	case "email":
+		cursor.Value = user.Email
+	case "createdAt":
+		if user.CreatedAt != nil {
+			cursor.Value = user.CreatedAt.Format(time.RFC3339Nano)
+		}
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value, field string) (any, uint, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, 0, errConstant.ErrInvalidCursor
+	}
+	var cursor userCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, 0, errConstant.ErrInvalidCursor
+	}
+	if field == "createdAt" {
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, 0, errConstant.ErrInvalidCursor
+		}
+		return createdAt, cursor.ID, nil
+	}
+	return cursor.Value, cursor.ID, nil
+}
+
+// scopeUserFilter menerapkan filter dan pencarian daftar user
+func scopeUserFilter(ctx context.Context, req *dto.UserListRequest) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if req.Search != "" {
+			search := "%" + strings.ToLower(req.Search) + "%"
+			db = db.Where(`(LOWER(users.name) LIKE ? OR LOWER(users.username) LIKE ?
+				OR LOWER(users.email) LIKE ? OR LOWER(users.phone_number) LIKE ?)`, search, search, search, search)
+		}
+		if req.Role != "" {
+			// pada tenant, role yang difilter adalah role user di tenant tersebut
+			if tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID); ok {
+				db = db.Where(`users.id IN (SELECT om.user_id FROM organization_members om
+					JOIN roles r ON r.id = om.role_id
+					JOIN organizations o ON o.id = om.organization_id
+					WHERE o.uuid = ? AND r.code = ?)`, tenant, strings.ToUpper(req.Role))
+			} else {
+				db = db.Where(`users.id IN (SELECT ur.user_id FROM user_roles ur
+					JOIN roles r ON r.id = ur.role_id WHERE r.code = ?)`, strings.ToUpper(req.Role))
+			}
+		}
+		if req.Verified != nil {
+			if *req.Verified {
+				db = db.Where("users.email_verified_at IS NOT NULL")
+			} else {
+				db = db.Where("users.email_verified_at IS NULL")
+			}
+		}
+		if req.Status != "" {
+			db = db.Where("users.status = ?", req.Status)
+			if req.Status == constants.UserDeleted {
+				db = db.Unscoped()
+			}
+		}
+		if req.CreatedFrom != nil {
+			db = db.Where("users.created_at >= ?", *req.CreatedFrom)
+		}
+		if req.CreatedTo != nil {
+			db = db.Where("users.created_at <= ?", *req.CreatedTo)
+		}
+		for _, key := range slices.Sorted(maps.Keys(req.Attributes)) {
+			db = db.Where("? = ?", attributeText(db, key), req.Attributes[key])
+		}
+		return db
+	}
+}
+
+// attributeText adalah nilai atribut key sebagai teks, dengan format yang sama di setiap
+// dialect: boolean menjadi "true" atau "false" dan angka ditulis apa adanya
+func attributeText(db *gorm.DB, key string) clause.Expr {
+	path := `$."` + strings.ReplaceAll(key, `"`, `\"`) + `"`
+	switch db.Dialector.Name() {
+	case constants.DatabaseMySQL:
+		return gorm.Expr("JSON_UNQUOTE(JSON_EXTRACT(users.attributes, ?))", path)
+	case constants.DatabaseSQLite:
+		// json_extract mengembalikan boolean JSON sebagai 1 atau 0
+		return gorm.Expr(`CASE json_type(users.attributes, ?) WHEN 'true' THEN 'true' WHEN 'false' THEN 'false'
+			ELSE CAST(json_extract(users.attributes, ?) AS TEXT) END`, path, path)
+	}
+	return gorm.Expr("jsonb_extract_path_text(users.attributes, ?)", key)
+}
+
+// FindAll mengambil daftar user dengan filter, sort, dan pagination.
+// Cursor dipakai jika ada; selain itu pagination berdasarkan halaman beserta total data.
+func (r *UserRepository) FindAll(ctx context.Context, req *dto.UserListRequest) ([]models.User, *response.Pagination, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	sort := req.Sort
+	if sort == "" {
+		sort = defaultListSort
+	}
+	field := strings.TrimPrefix(sort, "-")
+	column := sortColumns[field]
+	direction, comparator := "ASC", ">"
+	if strings.HasPrefix(sort, "-") {
+		direction, comparator = "DESC", "<"
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Scopes(scopeTenant(ctx), scopeUserFilter(ctx, req))
+	meta := &response.Pagination{Limit: limit}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(req.Cursor, field)
+		if err != nil {
+			return nil, nil, errWrap.WrapError(err)
+		}
+		query = query.Where(
+			fmt.Sprintf("(%s %s ? OR (%s = ? AND users.id %s ?))", column, comparator, column, comparator),
+			value, value, id,
+		)
+	} else {
+		meta.Page = req.Page
+		if meta.Page == 0 {
+			meta.Page = 1
+		}
+		err := query.Session(&gorm.Session{}).Count(&meta.Total).Error
+		if err != nil {
+			return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		meta.TotalPages = int((meta.Total + int64(limit) - 1) / int64(limit))
+		query = query.Offset((meta.Page - 1) * limit)
+	}
+
+	var users []models.User
+	err := query.
+		Scopes(preloadRoles(ctx)).
+		Order(fmt.Sprintf("%s %s, users.id %s", column, direction, direction)).
+		Limit(limit + 1).
+		Find(&users).Error
+	if err != nil {
+		return nil, nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	if len(users) > limit {
+		users = users[:limit]
+		meta.HasNext = true
+		meta.NextCursor = encodeCursor(&users[len(users)-1], field)
+	}
+	return users, meta, nil
+}
+
+// FindInBatches membaca user yang cocok dengan filter listing per batch berurutan id,
+// sehingga seluruh data dapat diproses tanpa dimuat sekaligus ke memori
+func (r *UserRepository) FindInBatches(ctx context.Context, req *dto.UserListRequest, size int, fn func([]models.User) error) error {
+	var (
+		users    []models.User
+		batchErr error
+	)
+	err := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Scopes(scopeTenant(ctx), scopeUserFilter(ctx, req), preloadRoles(ctx)).
+		FindInBatches(&users, size, func(tx *gorm.DB, batch int) error {
+			batchErr = fn(users)
+			return batchErr
+		}).Error
+	if batchErr != nil {
+		return batchErr
+	}
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindStatusByUUID membaca status akun user lintas tenant, termasuk user yang sudah dihapus
+func (r *UserRepository) FindStatusByUUID(ctx context.Context, userUUID uuid.UUID) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Select("id", "uuid", "status", "suspended_until", "tokens_revoked_at", "locale", "timezone", "deleted_at").
+		Where("uuid = ?", userUUID).
+		First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errConstant.ErrUserNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &user, nil
+}
+
+// FindExpiredSuspensions mengambil user lintas tenant yang masa suspend-nya sudah berakhir
+func (r *UserRepository) FindExpiredSuspensions(ctx context.Context, now time.Time) ([]models.User, error) {
+	var users []models.User
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND suspended_until IS NOT NULL AND suspended_until <= ?", constants.UserSuspended, now).
+		Find(&users).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return users, nil
+}
+
+// FindStatusHistory mengambil riwayat perubahan status user, terbaru lebih dulu
+func (r *UserRepository) FindStatusHistory(ctx context.Context, userID uint) ([]models.UserStatusHistory, error) {
+	var histories []models.UserStatusHistory
+	err := r.db.WithContext(ctx).
+		Preload("ChangedBy", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&histories).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return histories, nil
+}
+
+// ChangeStatus mengubah status user dan mencatat riwayatnya dalam satu transaksi.
+// Status deleted sekaligus melakukan soft delete.
+func (r *UserRepository) ChangeStatus(ctx context.Context, user *models.User, req *dto.UserStatusRequest, changedByID *uint) error {
+	history := &models.UserStatusHistory{
+		UserID:      user.ID,
+		FromStatus:  user.Status,
+		ToStatus:    req.Status,
+		Reason:      req.Reason,
+		Until:       req.Until,
+		ChangedByID: changedByID,
+	}
+
+	user.Status = req.Status
+	user.SuspendedReason = ""
+	user.SuspendedUntil = nil
+	if req.Status == constants.UserSuspended {
+		user.SuspendedReason = req.Reason
+		user.SuspendedUntil = req.Until
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(user).
+			Updates(map[string]any{
+				"status":           user.Status,
+				"suspended_reason": user.SuspendedReason,
+				"suspended_until":  user.SuspendedUntil,
+				"version":          gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+		if req.Status == constants.UserDeleted {
+			return tx.Delete(user).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	user.Version++
+	return nil
+}
+
+// UpdateAvatar menyimpan key avatar baru milik user; key kosong berarti avatar dihapus
+func (r *UserRepository) UpdateAvatar(ctx context.Context, user *models.User, key string) error {
+	err := r.db.WithContext(ctx).
+		Model(user).
+		Updates(map[string]any{"avatar_key": key, "version": gorm.Expr("version + 1")}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	user.Version++
+	return nil
+}
+
+// RevokeTokens mencabut seluruh token user yang terbit sebelum detik ini. Klaim iat JWT
+// berpresisi detik, sehingga batasnya dipotong ke detik yang sama agar login ulang tepat
+// setelah pencabutan tetap berlaku; token dicabut jika iat < tokens_revoked_at.
+func (r *UserRepository) RevokeTokens(ctx context.Context, user *models.User) error {
+	revokedAt := time.Now().Truncate(time.Second)
+	err := r.db.WithContext(ctx).
+		Model(user).
+		UpdateColumn("tokens_revoked_at", revokedAt).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	user.TokensRevokedAt = &revokedAt
+	return nil
+}
@@ -0,0 +1,298 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func newOrganization(t *testing.T, db *gorm.DB, code string) context.Context {
+	t.Helper()
+	organization := models.Organization{UUID: uuid.New(), Code: code, Name: code}
+	if err := db.Create(&organization).Error; err != nil {
+		t.Fatalf("create organization: %v", err)
+	}
+	return context.WithValue(context.Background(), constants.Tenant, organization.UUID)
+}
+
+func registerRequest(username, email string) *dto.RegisterRequest {
+	return &dto.RegisterRequest{
+		Name:        username,
+		Username:    username,
+		Email:       email,
+		Password:    "hashed",
+		PhoneNumber: "0800",
+	}
+}
+
+func TestRegisterIsUniquePerTenant(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	acme := newOrganization(t, db, "acme")
+	globex := newOrganization(t, db, "globex")
+
+	for name, ctx := range map[string]context.Context{"no tenant": context.Background(), "acme": acme, "globex": globex} {
+		if _, err := repository.Register(ctx, registerRequest("alice", "alice@example.com")); err != nil {
+			t.Fatalf("Register in %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		username string
+		email    string
+		want     error
+	}{
+		{"username differs only in case", acme, "ALICE", "other@example.com", errConstant.ErrUsernameExist},
+		{"email differs only in case", acme, "bob", "Alice@Example.com", errConstant.ErrEmailExist},
+		{"username without tenant", context.Background(), "Alice", "other@example.com", errConstant.ErrUsernameExist},
+		{"new user in tenant", acme, "bob", "bob@example.com", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := repository.Register(test.ctx, registerRequest(test.username, test.email))
+			if !errors.Is(err, test.want) {
+				t.Errorf("Register = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestRegisterBatchAllowsDuplicatesInOtherTenants(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	acme := newOrganization(t, db, "acme")
+	globex := newOrganization(t, db, "globex")
+
+	batch := []dto.RegisterRequest{*registerRequest("alice", "alice@example.com"), *registerRequest("bob", "bob@example.com")}
+	if _, err := repository.RegisterBatch(acme, batch); err != nil {
+		t.Fatalf("RegisterBatch in acme: %v", err)
+	}
+	users, err := repository.RegisterBatch(globex, batch)
+	if err != nil {
+		t.Fatalf("RegisterBatch in globex: %v", err)
+	}
+	if len(users) != 2 || users[0].UUID == uuid.Nil {
+		t.Errorf("RegisterBatch returned %d users", len(users))
+	}
+
+	// satu baris ganda membatalkan seluruh batch
+	_, err = repository.RegisterBatch(globex, []dto.RegisterRequest{*registerRequest("carol", "carol@example.com"), *registerRequest("Bob", "bob2@example.com")})
+	if !errors.Is(err, errConstant.ErrUsernameExist) {
+		t.Fatalf("RegisterBatch with a duplicate = %v, want ErrUsernameExist", err)
+	}
+	existing, err := repository.FindByUsername(globex, "carol")
+	if err != nil || existing != nil {
+		t.Errorf("carol was stored although the batch failed: %v, %v", existing, err)
+	}
+}
+
+func TestTenantScoping(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	acme := newOrganization(t, db, "acme")
+	globex := newOrganization(t, db, "globex")
+
+	alice, err := repository.Register(acme, registerRequest("alice", "alice@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repository.Register(globex, registerRequest("bob", "bob@example.com")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repository.Register(context.Background(), registerRequest("carol", "carol@example.com")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repository.FindByUUID(acme, alice.UUID.String()); err != nil {
+		t.Errorf("FindByUUID in own tenant: %v", err)
+	}
+	for name, ctx := range map[string]context.Context{"other tenant": globex, "no tenant": context.Background()} {
+		if _, err := repository.FindByUUID(ctx, alice.UUID.String()); !errors.Is(err, errConstant.ErrUserNotFound) {
+			t.Errorf("FindByUUID from %s = %v, want ErrUserNotFound", name, err)
+		}
+	}
+	if user, err := repository.FindByUsername(globex, "alice"); err != nil || user != nil {
+		t.Errorf("FindByUsername from other tenant = %v, %v", user, err)
+	}
+
+	tests := map[string]struct {
+		ctx  context.Context
+		want []string
+	}{
+		"acme":      {acme, []string{"alice"}},
+		"globex":    {globex, []string{"bob"}},
+		"no tenant": {context.Background(), []string{"carol"}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			users, meta, err := repository.FindAll(test.ctx, &dto.UserListRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{}
+			for _, user := range users {
+				got = append(got, user.Username)
+			}
+			if len(got) != len(test.want) || got[0] != test.want[0] || meta.Total != int64(len(test.want)) {
+				t.Errorf("FindAll = %v (total %d), want %v", got, meta.Total, test.want)
+			}
+		})
+	}
+}
+
+func TestFindAllFiltersAttributesAsText(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	ctx := context.Background()
+
+	for username, attributes := range map[string]map[string]any{
+		"alice": {"remote": true, "level": float64(3), "team": "core"},
+		"bob":   {"remote": false, "level": 2.5, "team": "ops"},
+	} {
+		req := registerRequest(username, username+"@example.com")
+		req.Attributes = attributes
+		if _, err := repository.Register(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		key, value, want string
+	}{
+		{"remote", "true", "alice"},
+		{"remote", "false", "bob"},
+		{"level", "3", "alice"},
+		{"level", "2.5", "bob"},
+		{"team", "ops", "bob"},
+	}
+	for _, test := range tests {
+		t.Run(test.key+"="+test.value, func(t *testing.T) {
+			users, _, err := repository.FindAll(ctx, &dto.UserListRequest{Attributes: map[string]string{test.key: test.value}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(users) != 1 || users[0].Username != test.want {
+				t.Errorf("FindAll matched %d users, want only %s", len(users), test.want)
+			}
+		})
+	}
+}
+
+func TestAttributeTextPerDialect(t *testing.T) {
+	dialectors := map[string]gorm.Dialector{
+		constants.DatabasePostgres: postgres.New(postgres.Config{DSN: "host=localhost"}),
+		constants.DatabaseMySQL:    mysql.New(mysql.Config{DSN: "user@tcp(localhost)/db", SkipInitializeWithVersion: true}),
+	}
+	want := map[string]string{
+		constants.DatabasePostgres: `SELECT * FROM "users" WHERE jsonb_extract_path_text(users.attributes, 'team') = 'core'`,
+		constants.DatabaseMySQL:    "SELECT * FROM `users` WHERE JSON_UNQUOTE(JSON_EXTRACT(users.attributes, '$.\"team\"')) = 'core'",
+	}
+	for name, dialector := range dialectors {
+		t.Run(name, func(t *testing.T) {
+			db, err := gorm.Open(dialector, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			query := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Table("users").Where("? = ?", attributeText(tx, "team"), "core").Find(&[]map[string]any{})
+			})
+			if query != want[name] {
+				t.Errorf("query = %s\nwant    %s", query, want[name])
+			}
+		})
+	}
+}
+
+func TestRevokeTokensKeepsTokensIssuedAfterwards(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	user, err := repository.Register(context.Background(), registerRequest("alice", "alice@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := jwt.NewNumericDate(time.Now().Add(-time.Second))
+	if err := repository.RevokeTokens(context.Background(), user); err != nil {
+		t.Fatal(err)
+	}
+	// login ulang pada detik yang sama dengan pencabutan
+	after := jwt.NewNumericDate(time.Now())
+
+	stored, err := repository.FindStatusByUUID(context.Background(), user.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revokedAt := *stored.TokensRevokedAt
+	if !before.Before(revokedAt) {
+		t.Errorf("token issued at %v is not revoked by %v", before.Time, revokedAt)
+	}
+	if after.Before(revokedAt) {
+		t.Errorf("token issued at %v after revocation at %v is revoked", after.Time, revokedAt)
+	}
+}
+
+func TestCheckTenantConflictCoversMembers(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := NewUserRepository(db)
+	acme := newOrganization(t, db, "acme")
+	var organization models.Organization
+	db.First(&organization, "code = ?", "acme")
+	role := models.Role{Code: "MEMBER", Name: "Member"}
+	if err := db.Create(&role).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repository.Register(acme, registerRequest("alice", "alice@acme.com")); err != nil {
+		t.Fatal(err)
+	}
+	platformAlice, err := repository.Register(context.Background(), registerRequest("alice", "alice@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dave, err := repository.Register(context.Background(), registerRequest("dave", "dave@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	member := models.OrganizationMember{OrganizationID: organization.ID, UserID: dave.ID, RoleID: role.ID}
+	if err := db.Create(&member).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		user          *models.User
+		organizations []uint
+		want          error
+	}{
+		"member sharing a native username": {platformAlice, []uint{organization.ID}, errConstant.ErrUsernameExist},
+		"platform user outside the tenant": {platformAlice, nil, nil},
+		"native user sharing a member email": {
+			&models.User{Username: "david", Email: "DAVE@example.com"}, []uint{organization.ID}, errConstant.ErrEmailExist,
+		},
+		"member renamed to a native username": {
+			&models.User{ID: dave.ID, Username: "Alice", Email: dave.Email}, nil, errConstant.ErrUsernameExist,
+		},
+		"member keeping its own username": {dave, nil, nil},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := repository.CheckTenantConflict(context.Background(), test.user, test.organizations...)
+			if !errors.Is(err, test.want) {
+				t.Errorf("CheckTenantConflict = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccessReviewRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAccessReviewRoute interface {
+	Run()
+}
+
+func NewAccessReviewRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAccessReviewRoute {
+	return &AccessReviewRoute{controller: controller, group: group}
+}
+
+func (a *AccessReviewRoute) Run() {
+	group := a.group.Group("/access-reviews")
+	group.Use(
+		middlewares.Authenticated(),
+		middlewares.CheckPlatform(),
+		middlewares.RequireScope(constants.ScopeAdmin),
+		middlewares.CheckRole(constants.RoleAdmin),
+	)
+	group.GET("", a.controller.GetAccessReviewController().GetAll)
+	group.POST("", a.controller.GetAccessReviewController().Create)
+	group.GET("/:uuid", a.controller.GetAccessReviewController().GetByUUID)
+	group.PUT("/:uuid/items/:itemUUID", a.controller.GetAccessReviewController().Decide)
+	group.POST("/:uuid/close", a.controller.GetAccessReviewController().Close)
+	group.GET("/:uuid/export", a.controller.GetAccessReviewController().Export)
+}
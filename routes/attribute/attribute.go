@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AttributeRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAttributeRoute interface {
+	Run()
+}
+
+func NewAttributeRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAttributeRoute {
+	return &AttributeRoute{controller: controller, group: group}
+}
+
+func (a *AttributeRoute) Run() {
+	group := a.group.Group("/attributes")
+	group.Use(middlewares.Authenticated())
+	// skema atribut boleh dibaca client untuk membangun form profil
+	group.GET("", a.controller.GetAttributeController().GetAll)
+	group.GET("/:uuid", a.controller.GetAttributeController().GetByUUID)
+
+	admin := group.Group("")
+	admin.Use(
+		middlewares.CheckPlatform(),
+		middlewares.RequireScope(constants.ScopeAdmin),
+		middlewares.CheckRole(constants.RoleAdmin),
+	)
+	admin.POST("", a.controller.GetAttributeController().Create)
+	admin.PUT("/:uuid", a.controller.GetAttributeController().Update)
+	admin.DELETE("/:uuid", a.controller.GetAttributeController().Delete)
+}
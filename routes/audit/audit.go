@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IAuditRoute interface {
+	Run()
+}
+
+func NewAuditRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IAuditRoute {
+	return &AuditRoute{controller: controller, group: group}
+}
+
+func (a *AuditRoute) Run() {
+	group := a.group.Group("/audit-logs")
+	// audit log mencakup seluruh tenant sehingga hanya admin platform yang boleh membacanya
+	group.Use(
+		middlewares.Authenticated(),
+		middlewares.CheckPlatform(),
+		middlewares.RequireScope(constants.ScopeAdmin),
+		middlewares.CheckRole(constants.RoleAdmin),
+	)
+	group.GET("", a.controller.GetAuditController().GetAll)
+}
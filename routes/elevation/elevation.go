@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ElevationRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IElevationRoute interface {
+	Run()
+}
+
+func NewElevationRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IElevationRoute {
+	return &ElevationRoute{controller: controller, group: group}
+}
+
+func (e *ElevationRoute) Run() {
+	// elevasi memberikan role global sehingga hanya tersedia di luar tenant
+	group := e.group.Group("/elevations")
+	group.Use(middlewares.Authenticated(), middlewares.CheckPlatform())
+	group.POST("", middlewares.RequireScope(constants.ScopeProfileWrite), e.controller.GetElevationController().Request)
+	group.GET("", middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin), e.controller.GetElevationController().GetAll)
+	group.POST("/:uuid/approve", middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin), e.controller.GetElevationController().Approve)
+	group.POST("/:uuid/reject", middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin), e.controller.GetElevationController().Reject)
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailChangeRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IEmailChangeRoute interface {
+	Run()
+}
+
+func NewEmailChangeRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IEmailChangeRoute {
+	return &EmailChangeRoute{controller: controller, group: group}
+}
+
+func (e *EmailChangeRoute) Run() {
+	// konfirmasi tidak butuh login; token dari email sudah membuktikan kepemilikan alamat baru
+	e.group.POST("/auth/email/confirm", e.controller.GetEmailChangeController().Confirm)
+
+	group := e.group.Group("/auth/me/email")
+	group.Use(middlewares.Authenticated())
+	group.GET("", middlewares.RequireScope(constants.ScopeProfileRead), e.controller.GetEmailChangeController().GetPending)
+	group.POST("", middlewares.RequireScope(constants.ScopeProfileWrite), e.controller.GetEmailChangeController().Request)
+	group.DELETE("", middlewares.RequireScope(constants.ScopeProfileWrite), e.controller.GetEmailChangeController().Cancel)
+}
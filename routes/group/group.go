@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GroupRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IGroupRoute interface {
+	Run()
+}
+
+func NewGroupRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IGroupRoute {
+	return &GroupRoute{controller: controller, group: group}
+}
+
+func (g *GroupRoute) Run() {
+	group := g.group.Group("/groups")
+	group.Use(middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin))
+	group.GET("", g.controller.GetGroupController().GetAll)
+	group.POST("", g.controller.GetGroupController().Create)
+	group.GET("/:uuid", g.controller.GetGroupController().GetByUUID)
+	group.PUT("/:uuid", g.controller.GetGroupController().Update)
+	group.DELETE("/:uuid", g.controller.GetGroupController().Delete)
+	group.GET("/:uuid/members", g.controller.GetGroupController().GetMembers)
+	group.POST("/:uuid/members", g.controller.GetGroupController().AddMember)
+	group.DELETE("/:uuid/members/:userUUID", g.controller.GetGroupController().RemoveMember)
+}
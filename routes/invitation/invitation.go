@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvitationRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IInvitationRoute interface {
+	Run()
+}
+
+func NewInvitationRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IInvitationRoute {
+	return &InvitationRoute{controller: controller, group: group}
+}
+
+func (i *InvitationRoute) Run() {
+	// menerima undangan tidak butuh login; token dari email sudah membuktikan kepemilikan alamat
+	i.group.POST("/auth/invitations/accept", i.controller.GetInvitationController().Accept)
+
+	group := i.group.Group("/invitations")
+	// admin platform mengundang user platform, admin tenant mengundang anggota tenant-nya
+	group.Use(middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin))
+	group.GET("", i.controller.GetInvitationController().GetAll)
+	group.POST("", i.controller.GetInvitationController().Create)
+	group.GET("/:uuid", i.controller.GetInvitationController().GetByUUID)
+	group.POST("/:uuid/resend", i.controller.GetInvitationController().Resend)
+	group.DELETE("/:uuid", i.controller.GetInvitationController().Revoke)
+}
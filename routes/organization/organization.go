@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IOrganizationRoute interface {
+	Run()
+}
+
+func NewOrganizationRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IOrganizationRoute {
+	return &OrganizationRoute{controller: controller, group: group}
+}
+
+func (o *OrganizationRoute) Run() {
+	group := o.group.Group("/organizations")
+	// admin platform mengelola semua organisasi, admin organisasi hanya organisasinya sendiri
+	group.Use(middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin), middlewares.CheckOrganization())
+	group.GET("", o.controller.GetOrganizationController().GetAll)
+	group.POST("", o.controller.GetOrganizationController().Create)
+	group.GET("/:uuid", o.controller.GetOrganizationController().GetByUUID)
+	group.PUT("/:uuid", o.controller.GetOrganizationController().Update)
+	group.GET("/:uuid/members", o.controller.GetOrganizationController().GetMembers)
+	group.PUT("/:uuid/members", o.controller.GetOrganizationController().SaveMember)
+	group.DELETE("/:uuid/members/:userUUID", o.controller.GetOrganizationController().RemoveMember)
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"user-service/constants"
+	"user-service/controllers"
+	"user-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PrivacyRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+}
+
+type IPrivacyRoute interface {
+	Run()
+}
+
+func NewPrivacyRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup) IPrivacyRoute {
+	return &PrivacyRoute{controller: controller, group: group}
+}
+
+func (p *PrivacyRoute) Run() {
+	group := p.group.Group("/auth/me")
+	group.Use(middlewares.Authenticated())
+	group.GET("/export", middlewares.RequireScope(constants.ScopeProfileRead), p.controller.GetPrivacyController().Export)
+	group.GET("/erasure", middlewares.RequireScope(constants.ScopeProfileRead), p.controller.GetPrivacyController().GetErasure)
+	group.POST("/erasure", middlewares.RequireScope(constants.ScopeProfileWrite), p.controller.GetPrivacyController().RequestErasure)
+	group.DELETE("/erasure", middlewares.RequireScope(constants.ScopeProfileWrite), p.controller.GetPrivacyController().CancelErasure)
+	// menghapus akun sendiri juga mengeluarkan seluruh sesi; pembatalan lewat DELETE /erasure setelah login ulang
+	group.DELETE("", middlewares.RequireScope(constants.ScopeProfileWrite), p.controller.GetPrivacyController().DeleteAccount)
+}
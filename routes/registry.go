@@ -2,6 +2,15 @@ package routes
 
 import (
 	"user-service/controllers"
+	accessReviewRoutes "user-service/routes/access_review"
+	attributeRoutes "user-service/routes/attribute"
+	auditRoutes "user-service/routes/audit"
+	elevationRoutes "user-service/routes/elevation"
+	emailChangeRoutes "user-service/routes/email_change"
+	groupRoutes "user-service/routes/group"
+	invitationRoutes "user-service/routes/invitation"
+	orgRoutes "user-service/routes/organization"
+	privacyRoutes "user-service/routes/privacy"
 	routes "user-service/routes/user"
 
 	"github.com/gin-gonic/gin"
@@ -22,8 +31,53 @@ func NewRouteRegistry(controller controllers.IControllerRegistry, group *gin.Rou
 
 func (r *Registry) Serve() {
 	r.userRoute().Run()
+	r.organizationRoute().Run()
+	r.groupRoute().Run()
+	r.elevationRoute().Run()
+	r.accessReviewRoute().Run()
+	r.privacyRoute().Run()
+	r.attributeRoute().Run()
+	r.emailChangeRoute().Run()
+	r.auditRoute().Run()
+	r.invitationRoute().Run()
 }
 
 func (r *Registry) userRoute() routes.IUserRoute {
 	return routes.NewUserRoute(r.controller, r.group)
 }
+
+func (r *Registry) organizationRoute() orgRoutes.IOrganizationRoute {
+	return orgRoutes.NewOrganizationRoute(r.controller, r.group)
+}
+
+func (r *Registry) groupRoute() groupRoutes.IGroupRoute {
+	return groupRoutes.NewGroupRoute(r.controller, r.group)
+}
+
+func (r *Registry) elevationRoute() elevationRoutes.IElevationRoute {
+	return elevationRoutes.NewElevationRoute(r.controller, r.group)
+}
+
+func (r *Registry) accessReviewRoute() accessReviewRoutes.IAccessReviewRoute {
+	return accessReviewRoutes.NewAccessReviewRoute(r.controller, r.group)
+}
+
+func (r *Registry) privacyRoute() privacyRoutes.IPrivacyRoute {
+	return privacyRoutes.NewPrivacyRoute(r.controller, r.group)
+}
+
+func (r *Registry) attributeRoute() attributeRoutes.IAttributeRoute {
+	return attributeRoutes.NewAttributeRoute(r.controller, r.group)
+}
+
+func (r *Registry) emailChangeRoute() emailChangeRoutes.IEmailChangeRoute {
+	return emailChangeRoutes.NewEmailChangeRoute(r.controller, r.group)
+}
+
+func (r *Registry) auditRoute() auditRoutes.IAuditRoute {
+	return auditRoutes.NewAuditRoute(r.controller, r.group)
+}
+
+func (r *Registry) invitationRoute() invitationRoutes.IInvitationRoute {
+	return invitationRoutes.NewInvitationRoute(r.controller, r.group)
+}
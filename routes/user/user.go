@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"user-service/config"
+	"user-service/constants"
 	"user-service/controllers"
 	"user-service/middlewares"
 
@@ -22,9 +24,34 @@ func NewUserRoute(controller controllers.IControllerRegistry, group *gin.RouterG
 
 func (u *UserRoute) Run() {
 	group := u.group.Group("/auth")
-	group.GET("/user", middlewares.Authenticated(), u.controller.GetUserController().GetUserLogin)
-	group.GET("/:uuid", middlewares.Authenticated(), u.controller.GetUserController().GetUserByUUID)
+	group.GET("/user", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeProfileRead), u.controller.GetUserController().GetUserLogin)
+	group.GET("/:uuid", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeUserRead), u.controller.GetUserController().GetUserByUUID)
 	group.POST("/login", u.controller.GetUserController().Login)
+	group.POST("/token", middlewares.Authenticated(), u.controller.GetUserController().IssueToken)
 	group.POST("/register", u.controller.GetUserController().Register)
-	group.PUT("/:uuid", middlewares.Authenticated(), u.controller.GetUserController().Update)
+	group.PUT("/:uuid", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeUserWrite), u.controller.GetUserController().Update)
+	group.PUT("/me/avatar", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeProfileWrite), u.controller.GetUserController().UploadAvatar)
+	group.DELETE("/me/avatar", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeProfileWrite), u.controller.GetUserController().DeleteAvatar)
+	group.GET("/me/logins", middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeProfileRead), u.controller.GetUserController().GetLoginHistory)
+
+	// anggota grup support (config supportGroups) boleh melihat data user tanpa role admin
+	readers := u.group.Group("/users")
+	readers.Use(
+		middlewares.Authenticated(),
+		middlewares.RequireScope(constants.ScopeUserRead),
+		middlewares.CheckAccess(
+			middlewares.HasRole(constants.RoleAdmin),
+			middlewares.InGroup(config.Config.SupportGroups...),
+		),
+	)
+	readers.GET("", u.controller.GetUserController().GetAll)
+	readers.GET("/:uuid/status-history", u.controller.GetUserController().GetStatusHistory)
+	readers.GET("/:uuid/logins", u.controller.GetUserController().GetUserLoginHistory)
+
+	users := u.group.Group("/users")
+	users.Use(middlewares.Authenticated(), middlewares.RequireScope(constants.ScopeAdmin), middlewares.CheckRole(constants.RoleAdmin))
+	users.POST("/import", u.controller.GetImportController().Import)
+	users.GET("/export", u.controller.GetUserController().Export)
+	users.PATCH("/:uuid", u.controller.GetUserController().Patch)
+	users.PUT("/:uuid/status", u.controller.GetUserController().ChangeStatus)
 }
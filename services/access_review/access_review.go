@@ -0,0 +1,272 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"user-service/common/i18n"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	userServices "user-service/services/user"
+)
+
+type AccessReviewService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAccessReviewService interface {
+	Create(context.Context, *dto.AccessReviewRequest) (*dto.AccessReviewResponse, error)
+	GetAll(context.Context) ([]dto.AccessReviewResponse, error)
+	GetByUUID(context.Context, string) (*dto.AccessReviewResponse, error)
+	Decide(context.Context, string, string, *dto.AccessReviewDecisionRequest) (*dto.AccessReviewResponse, error)
+	Close(context.Context, string) (*dto.AccessReviewResponse, error)
+	Export(context.Context, string, string) (*dto.ExportFile, error)
+}
+
+func NewAccessReviewService(repository repositories.IRepositoryRegistry) IAccessReviewService {
+	return &AccessReviewService{repository: repository}
+}
+
+func toAccessReviewResponse(review *models.AccessReview) *dto.AccessReviewResponse {
+	data := &dto.AccessReviewResponse{
+		UUID:      review.UUID,
+		Name:      review.Name,
+		Status:    review.Status,
+		CreatedBy: review.CreatedBy.UUID,
+		ClosedAt:  review.ClosedAt,
+		CreatedAt: review.CreatedAt,
+	}
+	if review.ClosedBy != nil {
+		data.ClosedBy = &review.ClosedBy.UUID
+	}
+	for _, item := range review.Items {
+		itemData := dto.AccessReviewItemResponse{
+			UUID:         item.UUID,
+			UserUUID:     item.User.UUID,
+			Username:     item.Username,
+			Email:        item.Email,
+			Role:         strings.ToLower(item.RoleCode),
+			Organization: item.OrganizationCode,
+			Reason:       item.Reason,
+			GrantedAt:    item.GrantedAt,
+			ExpiresAt:    item.ExpiresAt,
+			Decision:     item.Decision,
+			Note:         item.Note,
+			DecidedAt:    item.DecidedAt,
+		}
+		if item.DecidedBy != nil {
+			itemData.DecidedBy = &item.DecidedBy.UUID
+		}
+		data.Items = append(data.Items, itemData)
+	}
+	return data
+}
+
+// currentUser mengambil model user yang sedang login
+func (s *AccessReviewService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+func (s *AccessReviewService) Create(ctx context.Context, req *dto.AccessReviewRequest) (*dto.AccessReviewResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.repository.GetAccessReview().Create(ctx, req, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.GetAudit().Record(ctx, constants.AuditAccessReviewCreated, constants.SubjectReview, &review.UUID, map[string]any{
+		"name":  review.Name,
+		"items": len(review.Items),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAccessReviewResponse(review), nil
+}
+
+func (s *AccessReviewService) GetAll(ctx context.Context) ([]dto.AccessReviewResponse, error) {
+	reviews, err := s.repository.GetAccessReview().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.AccessReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		data = append(data, *toAccessReviewResponse(&review))
+	}
+	return data, nil
+}
+
+func (s *AccessReviewService) GetByUUID(ctx context.Context, uuid string) (*dto.AccessReviewResponse, error) {
+	review, err := s.repository.GetAccessReview().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toAccessReviewResponse(review), nil
+}
+
+func (s *AccessReviewService) Decide(ctx context.Context, uuid, itemUUID string, req *dto.AccessReviewDecisionRequest) (*dto.AccessReviewResponse, error) {
+	review, err := s.repository.GetAccessReview().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != constants.AccessReviewOpen {
+		return nil, errConstant.ErrAccessReviewClosed
+	}
+
+	var item *models.AccessReviewItem
+	for i := range review.Items {
+		if review.Items[i].UUID.String() == itemUUID {
+			item = &review.Items[i]
+			break
+		}
+	}
+	if item == nil {
+		return nil, errConstant.ErrAccessReviewItemNotFound
+	}
+
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.GetAccessReview().Decide(ctx, item, req, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.DecidedBy = user
+	return toAccessReviewResponse(review), nil
+}
+
+func (s *AccessReviewService) Close(ctx context.Context, uuid string) (*dto.AccessReviewResponse, error) {
+	review, err := s.repository.GetAccessReview().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != constants.AccessReviewOpen {
+		return nil, errConstant.ErrAccessReviewClosed
+	}
+	for _, item := range review.Items {
+		if item.Decision == constants.DecisionPending {
+			return nil, errConstant.ErrAccessReviewIncomplete
+		}
+	}
+
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// role dicabut bersama token pemiliknya karena role ikut tersimpan di klaim JWT
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetAccessReview().Close(ctx, review, user.ID); err != nil {
+			return err
+		}
+
+		revoked := 0
+		revokedUsers := map[uint]bool{}
+		for i := range review.Items {
+			item := &review.Items[i]
+			if item.Decision != constants.DecisionRevoke {
+				continue
+			}
+			revoked++
+			err := repository.GetAudit().Record(ctx, constants.AuditRoleRevoked, constants.SubjectUser, &item.User.UUID, map[string]any{
+				"accessReview": review.UUID,
+				"role":         strings.ToLower(item.RoleCode),
+				"organization": item.OrganizationCode,
+			})
+			if err != nil {
+				return err
+			}
+			if revokedUsers[item.UserID] {
+				continue
+			}
+			revokedUsers[item.UserID] = true
+			err = userServices.RevokeTokens(ctx, repository, &item.User, map[string]any{"accessReview": review.UUID})
+			if err != nil {
+				return err
+			}
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditAccessReviewClosed, constants.SubjectReview, &review.UUID, map[string]any{
+			"items":   len(review.Items),
+			"revoked": revoked,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	review.ClosedBy = user
+	return toAccessReviewResponse(review), nil
+}
+
+// Export menghasilkan bukti review yang sudah ditutup dalam format csv atau json
+func (s *AccessReviewService) Export(ctx context.Context, uuid, format string) (*dto.ExportFile, error) {
+	review, err := s.repository.GetAccessReview().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != constants.AccessReviewClosed {
+		return nil, errConstant.ErrAccessReviewNotClosed
+	}
+	data := i18n.InLocation(toAccessReviewResponse(review), i18n.Location(ctx)).(*dto.AccessReviewResponse)
+	name := fmt.Sprintf("access-review-%s", review.UUID)
+
+	switch format {
+	case "", "json":
+		content, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return &dto.ExportFile{Name: name + ".json", ContentType: "application/json", Content: content}, nil
+	case "csv":
+		content, err := accessReviewCSV(data)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.ExportFile{Name: name + ".csv", ContentType: "text/csv", Content: content}, nil
+	default:
+		return nil, errConstant.ErrInvalidExportFormat
+	}
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func accessReviewCSV(review *dto.AccessReviewResponse) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	rows := [][]string{{
+		"review", "user_uuid", "username", "email", "role", "organization", "reason",
+		"granted_at", "expires_at", "decision", "note", "decided_by", "decided_at",
+	}}
+	for _, item := range review.Items {
+		decidedBy := ""
+		if item.DecidedBy != nil {
+			decidedBy = item.DecidedBy.String()
+		}
+		rows = append(rows, []string{
+			review.UUID.String(), item.UserUUID.String(), item.Username, item.Email, item.Role, item.Organization, item.Reason,
+			formatTime(item.GrantedAt), formatTime(item.ExpiresAt), item.Decision, item.Note, decidedBy, formatTime(item.DecidedAt),
+		})
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"user-service/constants"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"gorm.io/gorm"
+)
+
+// openReview menyiapkan review terbuka dengan satu item role admin milik bob yang
+// diputuskan revoke oleh alice
+func openReview(t *testing.T, db *gorm.DB) (context.Context, IAccessReviewService, string, *models.User) {
+	t.Helper()
+	repository := repositories.NewRepositoryRegistry(db)
+	admin := models.Role{Code: "ADMIN", Name: "Admin", Privileged: true}
+	if err := db.Create(&admin).Error; err != nil {
+		t.Fatal(err)
+	}
+	register := func(username string, roleIDs ...uint) *models.User {
+		user, err := repository.GetUser().Register(context.Background(), &dto.RegisterRequest{
+			Name: username, Username: username, Email: username + "@example.com", Password: "x", PhoneNumber: "0", RoleIDs: roleIDs,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user
+	}
+	alice := register("alice")
+	bob := register("bob", admin.ID)
+
+	ctx := context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: alice.UUID})
+	service := NewAccessReviewService(repository)
+	review, err := service.Create(ctx, &dto.AccessReviewRequest{Name: "Q4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(review.Items) != 1 {
+		t.Fatalf("review has %d items, want 1", len(review.Items))
+	}
+	_, err = service.Decide(ctx, review.UUID.String(), review.Items[0].UUID.String(),
+		&dto.AccessReviewDecisionRequest{Decision: constants.DecisionRevoke})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx, service, review.UUID.String(), bob
+}
+
+func TestCloseRevokesRolesAndTokens(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx, service, review, bob := openReview(t, db)
+
+	if _, err := service.Close(ctx, review); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var roles int64
+	db.Model(&models.UserRole{}).Where("user_id = ?", bob.ID).Count(&roles)
+	var stored models.User
+	db.First(&stored, bob.ID)
+	if roles != 0 || stored.TokensRevokedAt == nil {
+		t.Errorf("bob keeps %d roles, tokens_revoked_at %v", roles, stored.TokensRevokedAt)
+	}
+	var actions []string
+	db.Model(&models.AuditLog{}).Order("id").Pluck("action", &actions)
+	for _, action := range []string{constants.AuditRoleRevoked, constants.AuditTokensRevoked, constants.AuditAccessReviewClosed} {
+		if !slices.Contains(actions, action) {
+			t.Errorf("audit actions %v miss %s", actions, action)
+		}
+	}
+}
+
+func TestCloseKeepsRolesWhenAuditFails(t *testing.T) {
+	db := dbtest.Open(t)
+	ctx, service, review, bob := openReview(t, db)
+
+	if err := db.Migrator().DropTable("audit_logs"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Close(ctx, review); err == nil {
+		t.Fatal("Close succeeded without an audit log")
+	}
+
+	var roles int64
+	db.Model(&models.UserRole{}).Where("user_id = ?", bob.ID).Count(&roles)
+	var stored models.User
+	db.First(&stored, bob.ID)
+	var status string
+	db.Model(&models.AccessReview{}).Pluck("status", &status)
+	if roles != 1 || stored.TokensRevokedAt != nil || status != constants.AccessReviewOpen {
+		t.Errorf("after a failed Close: %d roles, tokens_revoked_at %v, review %s", roles, stored.TokensRevokedAt, status)
+	}
+}
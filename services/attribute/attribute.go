@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"time"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+)
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+type AttributeService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAttributeService interface {
+	Create(context.Context, *dto.AttributeDefinitionRequest) (*dto.AttributeDefinitionResponse, error)
+	Update(context.Context, *dto.AttributeDefinitionRequest, string) (*dto.AttributeDefinitionResponse, error)
+	Delete(context.Context, string) error
+	GetAll(context.Context) ([]dto.AttributeDefinitionResponse, error)
+	GetByUUID(context.Context, string) (*dto.AttributeDefinitionResponse, error)
+}
+
+func NewAttributeService(repository repositories.IRepositoryRegistry) IAttributeService {
+	return &AttributeService{repository: repository}
+}
+
+func toAttributeResponse(definition *models.AttributeDefinition) *dto.AttributeDefinitionResponse {
+	return &dto.AttributeDefinitionResponse{
+		UUID:      definition.UUID,
+		Key:       definition.Key,
+		Label:     definition.Label,
+		Type:      definition.Type,
+		Required:  definition.Required,
+		Pattern:   definition.Pattern,
+		Min:       definition.Min,
+		Max:       definition.Max,
+		Options:   definition.Options,
+		CreatedAt: definition.CreatedAt,
+		UpdatedAt: definition.UpdatedAt,
+	}
+}
+
+// checkDefinition memastikan aturan validasi pada definisi dapat dipakai
+func checkDefinition(req *dto.AttributeDefinitionRequest) error {
+	if !attributeKeyPattern.MatchString(req.Key) {
+		return errConstant.ErrInvalidKey
+	}
+	if req.Pattern != "" {
+		if _, err := regexp.Compile(req.Pattern); err != nil {
+			return errConstant.ErrInvalidPattern
+		}
+	}
+	if req.Type == constants.AttributeEnum && len(req.Options) == 0 {
+		return errConstant.ErrAttributeOptions
+	}
+	return nil
+}
+
+func (s *AttributeService) Create(ctx context.Context, req *dto.AttributeDefinitionRequest) (*dto.AttributeDefinitionResponse, error) {
+	if err := checkDefinition(req); err != nil {
+		return nil, err
+	}
+	existing, err := s.repository.GetAttribute().FindByKey(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errConstant.ErrAttributeExist
+	}
+
+	definition, err := s.repository.GetAttribute().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toAttributeResponse(definition), nil
+}
+
+func (s *AttributeService) Update(ctx context.Context, req *dto.AttributeDefinitionRequest, uuid string) (*dto.AttributeDefinitionResponse, error) {
+	definition, err := s.repository.GetAttribute().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	// key dan tipe mengikuti definisi yang sudah ada
+	req.Key = definition.Key
+	req.Type = definition.Type
+	if err = checkDefinition(req); err != nil {
+		return nil, err
+	}
+
+	definition, err = s.repository.GetAttribute().Update(ctx, req, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toAttributeResponse(definition), nil
+}
+
+func (s *AttributeService) Delete(ctx context.Context, uuid string) error {
+	return s.repository.GetAttribute().Delete(ctx, uuid)
+}
+
+func (s *AttributeService) GetAll(ctx context.Context) ([]dto.AttributeDefinitionResponse, error) {
+	definitions, err := s.repository.GetAttribute().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.AttributeDefinitionResponse, 0, len(definitions))
+	for _, definition := range definitions {
+		data = append(data, *toAttributeResponse(&definition))
+	}
+	return data, nil
+}
+
+func (s *AttributeService) GetByUUID(ctx context.Context, uuid string) (*dto.AttributeDefinitionResponse, error) {
+	definition, err := s.repository.GetAttribute().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toAttributeResponse(definition), nil
+}
+
+// MergeAttributes menerapkan perubahan atribut ke nilai yang sudah ada.
+// Nilai null pada perubahan berarti atribut tersebut dihapus.
+func MergeAttributes(current, changes map[string]any) map[string]any {
+	merged := maps.Clone(current)
+	if merged == nil {
+		merged = map[string]any{}
+	}
+	for key, value := range changes {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// ValidateAttributes memeriksa atribut user terhadap seluruh definisi atribut.
+// Kesalahan dikembalikan sebagai FieldError agar controller dapat menampilkan detail per atribut.
+func ValidateAttributes(definitions []models.AttributeDefinition, values map[string]any) error {
+	return ValidateChanges(definitions, values, values)
+}
+
+// ValidateChanges hanya memeriksa atribut yang dikirim pada changes, ditambah atribut wajib
+// pada hasil gabungan values. Nilai lama milik atribut yang definisinya sudah dihapus tetap
+// tersimpan tanpa membuat perubahan profil lain gagal.
+func ValidateChanges(definitions []models.AttributeDefinition, values, changes map[string]any) error {
+	byKey := make(map[string]*models.AttributeDefinition, len(definitions))
+	for i := range definitions {
+		byKey[definitions[i].Key] = &definitions[i]
+	}
+
+	fields := []errWrap.ValidationResponse{}
+	for _, key := range slices.Sorted(maps.Keys(changes)) {
+		if _, ok := values[key]; !ok {
+			// atribut yang dihapus lewat null; atribut wajib diperiksa di bawah
+			continue
+		}
+		definition, ok := byKey[key]
+		if !ok {
+			fields = append(fields, errWrap.NewValidationResponse("attributes."+key, "%s is not a defined attribute", key))
+			continue
+		}
+		if invalid, ok := checkValue(definition, values[key]); !ok {
+			fields = append(fields, invalid)
+		}
+	}
+	for _, definition := range definitions {
+		if _, ok := values[definition.Key]; definition.Required && !ok {
+			fields = append(fields, errWrap.NewValidationResponse("attributes."+definition.Key, "%s is required", definition.Key))
+		}
+	}
+
+	if len(fields) > 0 {
+		return &errWrap.FieldError{Err: errConstant.ErrInvalidAttributes, Fields: fields}
+	}
+	return nil
+}
+
+// checkValue mengembalikan kesalahan validasi beserta false jika nilai tidak sesuai definisi
+func checkValue(definition *models.AttributeDefinition, value any) (errWrap.ValidationResponse, bool) {
+	key := definition.Key
+	field := "attributes." + key
+	switch definition.Type {
+	case constants.AttributeString:
+		text, ok := value.(string)
+		if !ok {
+			return errWrap.NewValidationResponse(field, "%s must be a string", key), false
+		}
+		length := float64(len([]rune(text)))
+		if definition.Min != nil && length < *definition.Min {
+			return errWrap.NewValidationResponse(field, "%s must be at least %v characters", key, *definition.Min), false
+		}
+		if definition.Max != nil && length > *definition.Max {
+			return errWrap.NewValidationResponse(field, "%s must be at most %v characters", key, *definition.Max), false
+		}
+		if definition.Pattern != "" {
+			pattern, err := regexp.Compile(definition.Pattern)
+			if err != nil || !pattern.MatchString(text) {
+				return errWrap.NewValidationResponse(field, "%s has an invalid format", key), false
+			}
+		}
+	case constants.AttributeNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return errWrap.NewValidationResponse(field, "%s must be a number", key), false
+		}
+		if definition.Min != nil && number < *definition.Min {
+			return errWrap.NewValidationResponse(field, "%s must be at least %v", key, *definition.Min), false
+		}
+		if definition.Max != nil && number > *definition.Max {
+			return errWrap.NewValidationResponse(field, "%s must be at most %v", key, *definition.Max), false
+		}
+	case constants.AttributeBoolean:
+		if _, ok := value.(bool); !ok {
+			return errWrap.NewValidationResponse(field, "%s must be a boolean", key), false
+		}
+	case constants.AttributeDate:
+		text, ok := value.(string)
+		if !ok {
+			return errWrap.NewValidationResponse(field, "%s must be a date (YYYY-MM-DD)", key), false
+		}
+		if _, err := time.Parse(constants.AttributeDateLayout, text); err != nil {
+			return errWrap.NewValidationResponse(field, "%s must be a date (YYYY-MM-DD)", key), false
+		}
+	case constants.AttributeEnum:
+		text, ok := value.(string)
+		if !ok || !slices.Contains(definition.Options, text) {
+			return errWrap.NewValidationResponse(field, "%s must be one of %v", key, definition.Options), false
+		}
+	}
+	return errWrap.ValidationResponse{}, true
+}
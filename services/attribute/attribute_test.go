@@ -0,0 +1,58 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	"user-service/domain/models"
+)
+
+func TestValidateChangesIgnoresUndefinedStoredValues(t *testing.T) {
+	definitions := []models.AttributeDefinition{
+		{Key: "department", Type: constants.AttributeString, Required: true},
+		{Key: "level", Type: constants.AttributeNumber},
+	}
+	// "legacy" tersimpan sebelum definisinya dihapus
+	current := map[string]any{"department": "sales", "legacy": "x"}
+
+	tests := []struct {
+		name    string
+		changes map[string]any
+		fields  []string
+	}{
+		{name: "unrelated change", changes: map[string]any{"level": float64(3)}},
+		{name: "no attribute change", changes: nil},
+		{name: "undefined key sent", changes: map[string]any{"legacy": "y"}, fields: []string{"attributes.legacy"}},
+		{name: "invalid value", changes: map[string]any{"level": "high"}, fields: []string{"attributes.level"}},
+		{name: "required key removed", changes: map[string]any{"department": nil}, fields: []string{"attributes.department"}},
+		{name: "undefined key removed", changes: map[string]any{"legacy": nil}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateChanges(definitions, MergeAttributes(current, test.changes), test.changes)
+			var fieldErr *errWrap.FieldError
+			if !errors.As(err, &fieldErr) {
+				if len(test.fields) > 0 {
+					t.Fatalf("error = %v, want invalid fields %v", err, test.fields)
+				}
+				return
+			}
+			got := []string{}
+			for _, field := range fieldErr.Fields {
+				got = append(got, field.Field)
+			}
+			if len(got) != len(test.fields) || (len(got) > 0 && got[0] != test.fields[0]) {
+				t.Errorf("invalid fields = %v, want %v", got, test.fields)
+			}
+		})
+	}
+}
+
+func TestValidateAttributesRejectsUndefinedKeys(t *testing.T) {
+	definitions := []models.AttributeDefinition{{Key: "department", Type: constants.AttributeString}}
+	err := ValidateAttributes(definitions, map[string]any{"legacy": "x"})
+	if err == nil {
+		t.Fatal("ValidateAttributes accepted an undefined attribute")
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"user-service/common/response"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+)
+
+type AuditService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAuditService interface {
+	GetAll(context.Context, *dto.AuditLogFilter) ([]dto.AuditLogResponse, *response.Pagination, error)
+}
+
+func NewAuditService(repository repositories.IRepositoryRegistry) IAuditService {
+	return &AuditService{repository: repository}
+}
+
+// ToAuditLogResponse mengubah entri audit menjadi response; dipakai juga oleh ekspor data pribadi
+func ToAuditLogResponse(auditLog *models.AuditLog) dto.AuditLogResponse {
+	data := dto.AuditLogResponse{
+		UUID:        auditLog.UUID,
+		ActorType:   auditLog.ActorType,
+		ActorUUID:   auditLog.ActorUUID,
+		ActorName:   auditLog.ActorName,
+		Action:      auditLog.Action,
+		SubjectType: auditLog.SubjectType,
+		SubjectUUID: auditLog.SubjectUUID,
+		RequestID:   auditLog.RequestID,
+		IPAddress:   auditLog.IPAddress,
+		CreatedAt:   auditLog.CreatedAt,
+	}
+	if auditLog.Changes != "" {
+		_ = json.Unmarshal([]byte(auditLog.Changes), &data.Changes)
+	}
+	if auditLog.Metadata != "" {
+		data.Metadata = json.RawMessage(auditLog.Metadata)
+	}
+	return data
+}
+
+func (s *AuditService) GetAll(ctx context.Context, filter *dto.AuditLogFilter) ([]dto.AuditLogResponse, *response.Pagination, error) {
+	auditLogs, meta, err := s.repository.GetAudit().FindAll(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make([]dto.AuditLogResponse, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		data = append(data, ToAuditLogResponse(&auditLog))
+	}
+	return data, meta, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"user-service/constants"
+	"user-service/domain/dto"
+)
+
+// Diff membandingkan dua snapshot dan mengembalikan field yang berubah. Snapshot nil
+// berarti data belum ada (dibuat) atau sudah tidak ada (dihapus). Nilai field rahasia dan
+// data pribadi diganti constants.AuditRedacted sehingga hanya fakta perubahannya yang tercatat.
+func Diff(before, after map[string]any) map[string]dto.AuditChange {
+	changes := map[string]dto.AuditChange{}
+	keys := slices.Collect(maps.Keys(before))
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		oldValue, newValue := before[key], after[key]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if Redacted(key) {
+			oldValue, newValue = redact(oldValue), redact(newValue)
+		}
+		changes[key] = dto.AuditChange{Before: oldValue, After: newValue}
+	}
+	return changes
+}
+
+// Redacted menandakan nilai field tidak boleh ditulis ke audit log
+func Redacted(key string) bool {
+	return slices.Contains(constants.AuditRedactedFields, key) || strings.HasPrefix(key, constants.AuditAttributePrefix)
+}
+
+func redact(value any) any {
+	if value == nil || value == "" {
+		return value
+	}
+	return constants.AuditRedacted
+}
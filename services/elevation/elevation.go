@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	userServices "user-service/services/user"
+
+	"github.com/sirupsen/logrus"
+)
+
+type ElevationService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IElevationService interface {
+	Request(context.Context, *dto.ElevationRequest) (*dto.ElevationResponse, error)
+	GetAll(context.Context, *dto.ElevationFilter) ([]dto.ElevationResponse, error)
+	Approve(context.Context, string) (*dto.ElevationResponse, error)
+	Reject(context.Context, string) (*dto.ElevationResponse, error)
+	ExpireElevations(context.Context) error
+}
+
+func NewElevationService(repository repositories.IRepositoryRegistry) IElevationService {
+	return &ElevationService{repository: repository}
+}
+
+func toElevationResponse(elevation *models.RoleElevation) *dto.ElevationResponse {
+	data := &dto.ElevationResponse{
+		UUID:            elevation.UUID,
+		UserUUID:        elevation.User.UUID,
+		Username:        elevation.User.Username,
+		Role:            strings.ToLower(elevation.Role.Code),
+		Reason:          elevation.Reason,
+		DurationMinutes: elevation.DurationMinutes,
+		Status:          elevation.Status,
+		ReviewedAt:      elevation.ReviewedAt,
+		StartsAt:        elevation.StartsAt,
+		ExpiresAt:       elevation.ExpiresAt,
+		CreatedAt:       elevation.CreatedAt,
+	}
+	if elevation.ReviewedBy != nil {
+		data.ReviewedBy = &elevation.ReviewedBy.UUID
+	}
+	return data
+}
+
+// elevationMetadata tidak menyertakan alasan elevasi: teks bebas bisa berisi data pribadi,
+// sedangkan audit log tidak ikut dihapus saat erasure. Alasannya tetap ada di baris elevasi.
+func elevationMetadata(elevation *models.RoleElevation) map[string]any {
+	return map[string]any{
+		"elevation": elevation.UUID,
+		"role":      strings.ToLower(elevation.Role.Code),
+		"startsAt":  elevation.StartsAt,
+		"expiresAt": elevation.ExpiresAt,
+	}
+}
+
+func (s *ElevationService) Request(ctx context.Context, req *dto.ElevationRequest) (*dto.ElevationResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	if config.Config.ElevationMaxMinutes > 0 && req.DurationMinutes > config.Config.ElevationMaxMinutes {
+		return nil, errConstant.ErrElevationDuration
+	}
+
+	user, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.repository.GetRole().FindByCodes(ctx, []string{req.Role})
+	if err != nil {
+		return nil, err
+	}
+
+	elevation, err := s.repository.GetElevation().Create(ctx, user.ID, roles[0].ID, req)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.GetAudit().Record(ctx, constants.AuditElevationRequested, constants.SubjectUser, &user.UUID, elevationMetadata(elevation))
+	if err != nil {
+		return nil, err
+	}
+	return toElevationResponse(elevation), nil
+}
+
+func (s *ElevationService) GetAll(ctx context.Context, filter *dto.ElevationFilter) ([]dto.ElevationResponse, error) {
+	elevations, err := s.repository.GetElevation().FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.ElevationResponse, 0, len(elevations))
+	for _, elevation := range elevations {
+		data = append(data, *toElevationResponse(&elevation))
+	}
+	return data, nil
+}
+
+// findReviewable mengambil elevasi pending beserta reviewer-nya; requester tidak boleh me-review permintaannya sendiri
+func (s *ElevationService) findReviewable(ctx context.Context, uuid string) (*models.RoleElevation, *models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	elevation, err := s.repository.GetElevation().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if elevation.Status != constants.ElevationPending {
+		return nil, nil, errConstant.ErrElevationNotPending
+	}
+	if elevation.User.UUID == userLogin.UUID {
+		return nil, nil, errConstant.ErrElevationSelfApproval
+	}
+
+	reviewer, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	return elevation, reviewer, nil
+}
+
+func (s *ElevationService) Approve(ctx context.Context, uuid string) (*dto.ElevationResponse, error) {
+	elevation, reviewer, err := s.findReviewable(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	startsAt := time.Now()
+	if elevation.StartsAt != nil && elevation.StartsAt.After(startsAt) {
+		startsAt = *elevation.StartsAt
+	}
+	expiresAt := startsAt.Add(time.Duration(elevation.DurationMinutes) * time.Minute)
+
+	// audit dicatat dalam transaksi yang sama agar role tidak pernah aktif tanpa jejak audit
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		err := repository.GetElevation().Approve(ctx, elevation, reviewer.ID, startsAt, expiresAt)
+		if err != nil {
+			return err
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditElevationApproved, constants.SubjectUser, &elevation.User.UUID, elevationMetadata(elevation))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	elevation.ReviewedBy = reviewer
+	return toElevationResponse(elevation), nil
+}
+
+func (s *ElevationService) Reject(ctx context.Context, uuid string) (*dto.ElevationResponse, error) {
+	elevation, reviewer, err := s.findReviewable(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetElevation().Reject(ctx, elevation, reviewer.ID); err != nil {
+			return err
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditElevationRejected, constants.SubjectUser, &elevation.User.UUID, elevationMetadata(elevation))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	elevation.ReviewedBy = reviewer
+	return toElevationResponse(elevation), nil
+}
+
+// ExpireElevations mencabut seluruh role sementara yang sudah melewati masa berlakunya
+// beserta token pemiliknya
+func (s *ElevationService) ExpireElevations(ctx context.Context) error {
+	elevations, err := s.repository.GetElevation().FindExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, elevation := range elevations {
+		err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+			if err := repository.GetElevation().Expire(ctx, &elevation); err != nil {
+				return err
+			}
+			// role sementara ikut tersimpan di klaim JWT, sehingga token user dicabut agar
+			// elevasi tidak berlaku lebih lama dari yang disetujui
+			err := userServices.RevokeTokens(ctx, repository, &elevation.User, map[string]any{"elevation": elevation.UUID})
+			if err != nil {
+				return err
+			}
+			return repository.GetAudit().Record(ctx, constants.AuditElevationExpired, constants.SubjectUser, &elevation.User.UUID, elevationMetadata(&elevation))
+		})
+		if err != nil {
+			return err
+		}
+		logrus.Infof("role elevation %s for user %s expired", elevation.UUID, elevation.User.Username)
+	}
+	return nil
+}
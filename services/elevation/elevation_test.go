@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"gorm.io/gorm"
+)
+
+type elevationFixture struct {
+	db        *gorm.DB
+	service   IElevationService
+	requester context.Context
+	reviewer  context.Context
+	bob       *models.User
+}
+
+// newElevationFixture menyiapkan bob sebagai peminta elevasi dan alice sebagai reviewer
+func newElevationFixture(t *testing.T) *elevationFixture {
+	t.Helper()
+	db := dbtest.Open(t)
+	repository := repositories.NewRepositoryRegistry(db)
+	admin := models.Role{Code: "ADMIN", Name: "Admin", Privileged: true}
+	if err := db.Create(&admin).Error; err != nil {
+		t.Fatal(err)
+	}
+	register := func(username string) *models.User {
+		user, err := repository.GetUser().Register(context.Background(), &dto.RegisterRequest{
+			Name: username, Username: username, Email: username + "@example.com", Password: "x", PhoneNumber: "0",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user
+	}
+	alice, bob := register("alice"), register("bob")
+	return &elevationFixture{
+		db:        db,
+		service:   NewElevationService(repository),
+		requester: context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: bob.UUID}),
+		reviewer:  context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: alice.UUID}),
+		bob:       bob,
+	}
+}
+
+func (f *elevationFixture) request(t *testing.T) string {
+	t.Helper()
+	elevation, err := f.service.Request(f.requester, &dto.ElevationRequest{Role: "admin", DurationMinutes: 30, Reason: "incident"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return elevation.UUID.String()
+}
+
+func TestExpireElevationsRevokesRoleAndTokens(t *testing.T) {
+	f := newElevationFixture(t)
+	uuid := f.request(t)
+	if _, err := f.service.Approve(f.reviewer, uuid); err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	past := time.Now().Add(-time.Minute)
+	f.db.Model(&models.RoleElevation{}).Where("uuid = ?", uuid).Update("expires_at", past)
+	f.db.Model(&models.UserRole{}).Where("user_id = ?", f.bob.ID).Update("expires_at", past)
+
+	if err := f.service.ExpireElevations(context.Background()); err != nil {
+		t.Fatalf("ExpireElevations: %v", err)
+	}
+
+	var roles int64
+	f.db.Model(&models.UserRole{}).Where("user_id = ?", f.bob.ID).Count(&roles)
+	var stored models.User
+	f.db.First(&stored, f.bob.ID)
+	if roles != 0 || stored.TokensRevokedAt == nil {
+		t.Errorf("bob keeps %d roles, tokens_revoked_at %v", roles, stored.TokensRevokedAt)
+	}
+	var actions []string
+	f.db.Model(&models.AuditLog{}).Order("id").Pluck("action", &actions)
+	for _, action := range []string{constants.AuditTokensRevoked, constants.AuditElevationExpired} {
+		if !slices.Contains(actions, action) {
+			t.Errorf("audit actions %v miss %s", actions, action)
+		}
+	}
+}
+
+// review yang kalah balapan tidak boleh menimpa keputusan yang sudah tersimpan
+func TestReviewRequiresPendingElevation(t *testing.T) {
+	f := newElevationFixture(t)
+	uuid := f.request(t)
+	repository := repositories.NewRepositoryRegistry(f.db)
+	stale, err := repository.GetElevation().FindByUUID(context.Background(), uuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.service.Approve(f.reviewer, uuid); err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+
+	if err := repository.GetElevation().Reject(context.Background(), stale, f.bob.ID); !errors.Is(err, errConstant.ErrElevationNotPending) {
+		t.Errorf("Reject of an approved elevation = %v, want ErrElevationNotPending", err)
+	}
+	stale.Status = constants.ElevationPending
+	err = repository.GetElevation().Approve(context.Background(), stale, f.bob.ID, time.Now(), time.Now().Add(time.Hour))
+	if !errors.Is(err, errConstant.ErrElevationNotPending) {
+		t.Errorf("second Approve = %v, want ErrElevationNotPending", err)
+	}
+
+	var status string
+	f.db.Model(&models.RoleElevation{}).Where("uuid = ?", uuid).Pluck("status", &status)
+	var expiresAt []time.Time
+	f.db.Model(&models.UserRole{}).Where("user_id = ?", f.bob.ID).Pluck("expires_at", &expiresAt)
+	if status != constants.ElevationApproved || len(expiresAt) != 1 || expiresAt[0].After(time.Now().Add(31*time.Minute)) {
+		t.Errorf("elevation %s with role expiring %v, want the first approval kept", status, expiresAt)
+	}
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	mailClient "user-service/clients/mail"
+	"user-service/common/i18n"
+	"user-service/common/utils"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type EmailChangeService struct {
+	repository repositories.IRepositoryRegistry
+	mailer     mailClient.IMailer
+}
+
+type IEmailChangeService interface {
+	Request(context.Context, *dto.EmailChangeRequest) (*dto.EmailChangeResponse, error)
+	GetPending(context.Context) (*dto.EmailChangeResponse, error)
+	Cancel(context.Context) (*dto.EmailChangeResponse, error)
+	Confirm(context.Context, *dto.EmailChangeConfirmRequest) (*dto.EmailChangeResponse, error)
+}
+
+func NewEmailChangeService(repository repositories.IRepositoryRegistry, mailer mailClient.IMailer) IEmailChangeService {
+	return &EmailChangeService{repository: repository, mailer: mailer}
+}
+
+func toEmailChangeResponse(change *models.EmailChange) *dto.EmailChangeResponse {
+	return &dto.EmailChangeResponse{
+		UUID:        change.UUID,
+		NewEmail:    change.NewEmail,
+		Status:      change.Status,
+		ExpiresAt:   change.ExpiresAt,
+		ConfirmedAt: change.ConfirmedAt,
+		CancelledAt: change.CancelledAt,
+		CreatedAt:   change.CreatedAt,
+	}
+}
+
+func (s *EmailChangeService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+// Request menyimpan email baru sebagai pending, mengirim link konfirmasi ke alamat baru
+// dan pemberitahuan ke alamat lama. Password diminta ulang agar sesi yang dibajak
+// tidak bisa mengalihkan email pemulihan.
+func (s *EmailChangeService) Request(ctx context.Context, req *dto.EmailChangeRequest) (*dto.EmailChangeResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, errConstant.ErrPasswordIncorrect
+	}
+	if strings.EqualFold(req.Email, user.Email) {
+		return nil, errConstant.ErrEmailUnchanged
+	}
+	existing, err := s.repository.GetUser().FindByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errConstant.ErrEmailExist
+	}
+
+	token, err := utils.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	expireMinutes := config.Config.EmailChangeExpireMinutes
+	if expireMinutes <= 0 {
+		expireMinutes = constants.DefaultEmailChangeExpireMinutes
+	}
+	expiresAt := time.Now().Add(time.Duration(expireMinutes) * time.Minute)
+	change, err := s.repository.GetEmailChange().Create(ctx, user.ID, req.Email, utils.HashToken(token), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// email dikirim dalam bahasa dan zona waktu pilihan user, atau mengikuti request jika belum diatur
+	userCtx := i18n.WithPreferences(ctx, user.Locale, user.Timezone)
+	locale, location := i18n.Locale(userCtx), i18n.Location(userCtx)
+	link := fmt.Sprintf("%s/email/confirm?token=%s", strings.TrimRight(config.Config.FrontendURL, "/"), url.QueryEscape(token))
+	err = s.mailer.Send(ctx, &mailClient.Message{
+		To:      change.NewEmail,
+		Subject: i18n.T(locale, "Confirm your new email address"),
+		Body: i18n.Sprintf(locale, "Hi %s,\n\nConfirm that %s is your new email address by opening the link below before %s:\n\n%s\n\nIf you did not request this change, ignore this email.",
+			user.Name, change.NewEmail, expiresAt.In(location).Format(time.RFC1123), link),
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.mailer.Send(ctx, &mailClient.Message{
+		To:      user.Email,
+		Subject: i18n.T(locale, "Your email address is being changed"),
+		Body: i18n.Sprintf(locale, "Hi %s,\n\nA request was made to change the email address of your account to %s. The change only takes effect once the new address is confirmed.\n\nIf this was not you, sign in and cancel the pending change, then change your password.",
+			user.Name, change.NewEmail),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetAudit().Record(ctx, constants.AuditEmailChangeRequested, constants.SubjectUser, &user.UUID, map[string]any{
+		"emailChange": change.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEmailChangeResponse(change), nil
+}
+
+func (s *EmailChangeService) GetPending(ctx context.Context) (*dto.EmailChangeResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := s.repository.GetEmailChange().FindPending(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, errConstant.ErrEmailChangeNotFound
+	}
+	return toEmailChangeResponse(change), nil
+}
+
+func (s *EmailChangeService) Cancel(ctx context.Context) (*dto.EmailChangeResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change, err := s.repository.GetEmailChange().FindPending(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, errConstant.ErrEmailChangeNotFound
+	}
+
+	if err = s.repository.GetEmailChange().Cancel(ctx, change); err != nil {
+		return nil, err
+	}
+	err = s.repository.GetAudit().Record(ctx, constants.AuditEmailChangeCancelled, constants.SubjectUser, &user.UUID, map[string]any{
+		"emailChange": change.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEmailChangeResponse(change), nil
+}
+
+// Confirm mengganti email user setelah link konfirmasi dibuka. Token sendiri yang
+// membuktikan kepemilikan alamat baru sehingga endpoint ini tidak butuh login.
+func (s *EmailChangeService) Confirm(ctx context.Context, req *dto.EmailChangeConfirmRequest) (*dto.EmailChangeResponse, error) {
+	change, err := s.repository.GetEmailChange().FindPendingByToken(ctx, utils.HashToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, errConstant.ErrEmailChangeInvalid
+	}
+
+	// keunikan email dicek pada tenant milik user, bukan tenant dari header request.
+	// Tanpa pre-check, email ganda ditolak oleh unique index saat konfirmasi.
+	if config.Config.UniquePreCheck {
+		var tenant any
+		if change.User.Organization != nil {
+			tenant = change.User.Organization.UUID
+		}
+		userCtx := context.WithValue(ctx, constants.Tenant, tenant)
+		existing, err := s.repository.GetUser().FindByEmail(userCtx, change.NewEmail)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != change.UserID {
+			return nil, errConstant.ErrEmailExist
+		}
+	}
+
+	// unique index tidak mencakup anggota tenant, sehingga bentrok dengan mereka selalu dicek
+	candidate := change.User
+	candidate.Email = change.NewEmail
+	if err = s.repository.GetUser().CheckTenantConflict(ctx, &candidate); err != nil {
+		return nil, err
+	}
+	if err = s.repository.GetEmailChange().Confirm(ctx, change); err != nil {
+		return nil, err
+	}
+	changes := map[string]dto.AuditChange{
+		"email": {Before: change.User.Email, After: change.NewEmail},
+	}
+	err = s.repository.GetAudit().RecordChanges(ctx, constants.AuditEmailChangeConfirmed, constants.SubjectUser, &change.User.UUID, changes, map[string]any{
+		"emailChange": change.UUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEmailChangeResponse(change), nil
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"user-service/constants"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+type GroupService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IGroupService interface {
+	Create(context.Context, *dto.GroupRequest) (*dto.GroupResponse, error)
+	Update(context.Context, *dto.GroupRequest, string) (*dto.GroupResponse, error)
+	Delete(context.Context, string) error
+	GetAll(context.Context) ([]dto.GroupResponse, error)
+	GetByUUID(context.Context, string) (*dto.GroupResponse, error)
+	GetMembers(context.Context, string) ([]dto.GroupMemberResponse, error)
+	AddMember(context.Context, string, *dto.GroupMemberRequest) error
+	RemoveMember(context.Context, string, string) error
+}
+
+func NewGroupService(repository repositories.IRepositoryRegistry) IGroupService {
+	return &GroupService{repository: repository}
+}
+
+func toGroupResponse(group *models.Group) *dto.GroupResponse {
+	return &dto.GroupResponse{
+		UUID:        group.UUID,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
+
+func (s *GroupService) Create(ctx context.Context, req *dto.GroupRequest) (*dto.GroupResponse, error) {
+	group, err := s.repository.GetGroup().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupResponse(group), nil
+}
+
+func (s *GroupService) Update(ctx context.Context, req *dto.GroupRequest, uuid string) (*dto.GroupResponse, error) {
+	group, err := s.repository.GetGroup().Update(ctx, req, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupResponse(group), nil
+}
+
+func (s *GroupService) Delete(ctx context.Context, uuid string) error {
+	return s.repository.GetGroup().Delete(ctx, uuid)
+}
+
+func (s *GroupService) GetAll(ctx context.Context) ([]dto.GroupResponse, error) {
+	groups, err := s.repository.GetGroup().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.GroupResponse, 0, len(groups))
+	for _, group := range groups {
+		data = append(data, *toGroupResponse(&group))
+	}
+	return data, nil
+}
+
+func (s *GroupService) GetByUUID(ctx context.Context, uuid string) (*dto.GroupResponse, error) {
+	group, err := s.repository.GetGroup().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupResponse(group), nil
+}
+
+func (s *GroupService) GetMembers(ctx context.Context, uuid string) ([]dto.GroupMemberResponse, error) {
+	group, err := s.repository.GetGroup().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repository.GetGroup().FindMembers(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.GroupMemberResponse, 0, len(members))
+	for _, member := range members {
+		data = append(data, dto.GroupMemberResponse{
+			UUID:     member.User.UUID,
+			Name:     member.User.Name,
+			Username: member.User.Username,
+			Email:    member.User.Email,
+			JoinedAt: member.CreatedAt,
+		})
+	}
+	return data, nil
+}
+
+func (s *GroupService) AddMember(ctx context.Context, uuid string, req *dto.GroupMemberRequest) error {
+	group, err := s.repository.GetGroup().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	// user dicari dalam tenant yang sama sehingga grup tidak bisa berisi user tenant lain
+	user, err := s.repository.GetUser().FindByUUID(ctx, req.UserUUID)
+	if err != nil {
+		return err
+	}
+	return s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetGroup().AddMember(ctx, group.ID, user.ID); err != nil {
+			return err
+		}
+		return recordMembership(ctx, repository, constants.AuditGroupMemberAdded, &user.UUID, nil, &group.UUID)
+	})
+}
+
+func (s *GroupService) RemoveMember(ctx context.Context, uuid, userUUID string) error {
+	group, err := s.repository.GetGroup().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	user, err := s.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+	return s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetGroup().DeleteMember(ctx, group.ID, user.ID); err != nil {
+			return err
+		}
+		return recordMembership(ctx, repository, constants.AuditGroupMemberRemoved, &user.UUID, &group.UUID, nil)
+	})
+}
+
+// recordMembership mencatat perubahan keanggotaan grup pada audit log user karena grup
+// ikut menentukan hak akses (InGroup)
+func recordMembership(ctx context.Context, repository repositories.IRepositoryRegistry, action string, user, before, after *uuid.UUID) error {
+	changes := map[string]dto.AuditChange{"group": {Before: before, After: after}}
+	return repository.GetAudit().RecordChanges(ctx, action, constants.SubjectUser, user, changes, nil)
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	mailClient "user-service/clients/mail"
+	"user-service/common/i18n"
+	"user-service/common/utils"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	attributeServices "user-service/services/attribute"
+	auditServices "user-service/services/audit"
+	userServices "user-service/services/user"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type InvitationService struct {
+	repository repositories.IRepositoryRegistry
+	mailer     mailClient.IMailer
+}
+
+type IInvitationService interface {
+	Create(context.Context, *dto.InvitationRequest) (*dto.InvitationResponse, error)
+	GetAll(context.Context, *dto.InvitationFilter) ([]dto.InvitationResponse, error)
+	GetByUUID(context.Context, string) (*dto.InvitationResponse, error)
+	Resend(context.Context, string) (*dto.InvitationResponse, error)
+	Revoke(context.Context, string) (*dto.InvitationResponse, error)
+	Accept(context.Context, *dto.InvitationAcceptRequest) (*dto.InvitationResponse, error)
+}
+
+func NewInvitationService(repository repositories.IRepositoryRegistry, mailer mailClient.IMailer) IInvitationService {
+	return &InvitationService{repository: repository, mailer: mailer}
+}
+
+func toInvitationResponse(invitation *models.Invitation) *dto.InvitationResponse {
+	data := &dto.InvitationResponse{
+		UUID:       invitation.UUID,
+		Email:      invitation.Email,
+		Name:       invitation.Name,
+		Role:       strings.ToLower(invitation.Role.Code),
+		Status:     invitation.Status,
+		ExpiresAt:  invitation.ExpiresAt,
+		AcceptedAt: invitation.AcceptedAt,
+		RevokedAt:  invitation.RevokedAt,
+		CreatedAt:  invitation.CreatedAt,
+	}
+	if invitation.Status == constants.InvitationPending && !invitation.ExpiresAt.After(time.Now()) {
+		data.Status = constants.InvitationExpired
+	}
+	if invitation.InvitedBy != nil {
+		data.InvitedBy = &invitation.InvitedBy.UUID
+	}
+	if invitation.User != nil {
+		data.UserUUID = &invitation.User.UUID
+	}
+	return data
+}
+
+func expiresAt() time.Time {
+	expireMinutes := config.Config.InvitationExpireMinutes
+	if expireMinutes <= 0 {
+		expireMinutes = constants.DefaultInvitationExpireMinutes
+	}
+	return time.Now().Add(time.Duration(expireMinutes) * time.Minute)
+}
+
+// send mengirim link undangan ke email yang diundang. Calon user belum punya preferensi,
+// sehingga bahasa dan zona waktu mengikuti request admin yang mengundang.
+func (s *InvitationService) send(ctx context.Context, invitation *models.Invitation, token string) error {
+	locale := i18n.Locale(ctx)
+	link := fmt.Sprintf("%s/invitations/accept?token=%s", strings.TrimRight(config.Config.FrontendURL, "/"), url.QueryEscape(token))
+	return s.mailer.Send(ctx, &mailClient.Message{
+		To:      invitation.Email,
+		Subject: i18n.T(locale, "You have been invited to create an account"),
+		Body: i18n.Sprintf(locale, "Hi %s,\n\nYou have been invited to create an account as %s. Open the link below before %s to choose your username and password:\n\n%s\n\nIf you were not expecting this invitation, ignore this email.",
+			invitation.Name, strings.ToLower(invitation.Role.Code), invitation.ExpiresAt.In(i18n.Location(ctx)).Format(time.RFC1123), link),
+	})
+}
+
+// Create mengundang email dengan role tertentu ke tenant aktif, atau ke platform jika tanpa tenant
+func (s *InvitationService) Create(ctx context.Context, req *dto.InvitationRequest) (*dto.InvitationResponse, error) {
+	roles, err := s.repository.GetRole().FindByCodes(ctx, []string{req.Role})
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, errConstant.ErrRoleNotFound
+	}
+	existing, err := s.repository.GetUser().FindByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errConstant.ErrEmailExist
+	}
+	pending, err := s.repository.GetInvitation().FindPendingByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return nil, errConstant.ErrInvitationExist
+	}
+
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	invitedBy, err := s.repository.GetUser().FindStatusByUUID(ctx, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+	token, err := utils.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &models.Invitation{
+		UUID:        uuid.New(),
+		Email:       req.Email,
+		Name:        req.Name,
+		RoleID:      roles[0].ID,
+		TokenHash:   utils.HashToken(token),
+		Status:      constants.InvitationPending,
+		ExpiresAt:   expiresAt(),
+		InvitedByID: &invitedBy.ID,
+		Role:        roles[0],
+		InvitedBy:   invitedBy,
+	}
+	if tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID); ok {
+		organization, err := s.repository.GetOrganization().FindByUUID(ctx, tenant.String())
+		if err != nil {
+			return nil, err
+		}
+		invitation.OrganizationID = &organization.ID
+	}
+
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetInvitation().Create(ctx, invitation); err != nil {
+			return err
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditInvitationCreated, constants.SubjectInvitation, &invitation.UUID, map[string]any{
+			"role": req.Role,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	// email dikirim setelah commit agar tidak ada link undangan yang tidak tersimpan;
+	// jika pengiriman gagal, undangan bisa dikirim ulang lewat Resend
+	if err = s.send(ctx, invitation, token); err != nil {
+		return nil, err
+	}
+	return toInvitationResponse(invitation), nil
+}
+
+func (s *InvitationService) GetAll(ctx context.Context, filter *dto.InvitationFilter) ([]dto.InvitationResponse, error) {
+	invitations, err := s.repository.GetInvitation().FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		data = append(data, *toInvitationResponse(&invitation))
+	}
+	return data, nil
+}
+
+func (s *InvitationService) GetByUUID(ctx context.Context, uuid string) (*dto.InvitationResponse, error) {
+	invitation, err := s.repository.GetInvitation().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toInvitationResponse(invitation), nil
+}
+
+// Resend mengirim ulang undangan yang belum diterima dengan token dan masa berlaku baru
+func (s *InvitationService) Resend(ctx context.Context, uuid string) (*dto.InvitationResponse, error) {
+	invitation, err := s.repository.GetInvitation().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.Status != constants.InvitationPending {
+		return nil, errConstant.ErrInvitationNotPending
+	}
+
+	token, err := utils.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetInvitation().Renew(ctx, invitation, utils.HashToken(token), expiresAt()); err != nil {
+			return err
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditInvitationResent, constants.SubjectInvitation, &invitation.UUID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = s.send(ctx, invitation, token); err != nil {
+		return nil, err
+	}
+	return toInvitationResponse(invitation), nil
+}
+
+func (s *InvitationService) Revoke(ctx context.Context, uuid string) (*dto.InvitationResponse, error) {
+	invitation, err := s.repository.GetInvitation().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetInvitation().Revoke(ctx, invitation); err != nil {
+			return err
+		}
+		return repository.GetAudit().Record(ctx, constants.AuditInvitationRevoked, constants.SubjectInvitation, &invitation.UUID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInvitationResponse(invitation), nil
+}
+
+// checkUnique memastikan username dan email belum dipakai sebelum user dibuat. Keunikan
+// dicek pada tenant undangan, bukan tenant dari header request.
+func (s *InvitationService) checkUnique(ctx context.Context, invitation *models.Invitation, username string) error {
+	var tenant any
+	if invitation.Organization != nil {
+		tenant = invitation.Organization.UUID
+	}
+	userCtx := context.WithValue(ctx, constants.Tenant, tenant)
+	existing, err := s.repository.GetUser().FindByUsername(userCtx, username)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errConstant.ErrUsernameExist
+	}
+	existing, err = s.repository.GetUser().FindByEmail(userCtx, invitation.Email)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errConstant.ErrEmailExist
+	}
+	return nil
+}
+
+// Accept membuat akun dari undangan. Token dari email membuktikan kepemilikan alamat
+// sehingga endpoint ini tidak butuh login dan email user langsung terverifikasi.
+func (s *InvitationService) Accept(ctx context.Context, req *dto.InvitationAcceptRequest) (*dto.InvitationResponse, error) {
+	invitation, err := s.repository.GetInvitation().FindPendingByToken(ctx, utils.HashToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, errConstant.ErrInvitationInvalid
+	}
+	if req.Password != req.ConfirmPassword {
+		return nil, errConstant.ErrPasswordDoesNotMatch
+	}
+
+	if config.Config.UniquePreCheck {
+		if err = s.checkUnique(ctx, invitation, req.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	definitions, err := s.repository.GetAttribute().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attributes := attributeServices.MergeAttributes(nil, req.Attributes)
+	if err = attributeServices.ValidateAttributes(definitions, attributes); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = invitation.Name
+	}
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if invitation.OrganizationID != nil {
+			candidate := &models.User{Username: req.Username, Email: invitation.Email}
+			if err := repository.GetUser().CheckTenantConflict(ctx, candidate, *invitation.OrganizationID); err != nil {
+				return err
+			}
+		}
+		user, err := repository.GetUser().RegisterInvited(ctx, &dto.RegisterRequest{
+			Name:        name,
+			Username:    req.Username,
+			Password:    string(hashedPassword),
+			PhoneNumber: req.PhoneNumber,
+			Email:       invitation.Email,
+			Attributes:  attributes,
+			Locale:      req.Locale,
+			Timezone:    req.Timezone,
+		}, invitation)
+		if err != nil {
+			return err
+		}
+		invitation.User = user
+
+		changes := auditServices.Diff(nil, userServices.AuditSnapshot(user))
+		return repository.GetAudit().RecordChanges(ctx, constants.AuditInvitationAccepted, constants.SubjectUser, &user.UUID, changes, map[string]any{
+			"invitation": invitation.UUID,
+			"role":       strings.ToLower(invitation.Role.Code),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInvitationResponse(invitation), nil
+}
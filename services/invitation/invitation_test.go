@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"testing"
+	mailClient "user-service/clients/mail"
+	"user-service/constants"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+)
+
+type recordingMailer struct {
+	messages []*mailClient.Message
+}
+
+func (m *recordingMailer) Send(_ context.Context, message *mailClient.Message) error {
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+func TestCreateSendsNothingWhenAuditFails(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := repositories.NewRepositoryRegistry(db)
+	mailer := &recordingMailer{}
+	service := NewInvitationService(repository, mailer)
+	if err := db.Create(&models.Role{Code: "VIEWER", Name: "Viewer"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	admin, err := repository.GetUser().Register(context.Background(), &dto.RegisterRequest{
+		Name: "admin", Username: "admin", Email: "admin@example.com", Password: "x", PhoneNumber: "0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: admin.UUID})
+	req := &dto.InvitationRequest{Email: "carol@example.com", Name: "Carol", Role: "viewer"}
+
+	// tabel audit yang hilang membuat transaksi gagal setelah undangan dibuat
+	if err := db.Migrator().RenameTable("audit_logs", "audit_logs_backup"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Create(ctx, req); err == nil {
+		t.Fatal("Create succeeded without an audit table")
+	}
+	var invitations int64
+	db.Model(&models.Invitation{}).Count(&invitations)
+	if invitations != 0 || len(mailer.messages) != 0 {
+		t.Errorf("after a failed Create: %d invitations, %d emails sent", invitations, len(mailer.messages))
+	}
+
+	if err := db.Migrator().RenameTable("audit_logs_backup", "audit_logs"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Create(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+	db.Model(&models.Invitation{}).Count(&invitations)
+	if invitations != 1 || len(mailer.messages) != 1 || mailer.messages[0].To != req.Email {
+		t.Errorf("after Create: %d invitations, emails %+v", invitations, mailer.messages)
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	auditServices "user-service/services/audit"
+)
+
+type OrganizationService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IOrganizationService interface {
+	Create(context.Context, *dto.OrganizationRequest) (*dto.OrganizationResponse, error)
+	Update(context.Context, *dto.OrganizationRequest, string) (*dto.OrganizationResponse, error)
+	GetAll(context.Context) ([]dto.OrganizationResponse, error)
+	GetByUUID(context.Context, string) (*dto.OrganizationResponse, error)
+	GetMembers(context.Context, string) ([]dto.OrganizationMemberResponse, error)
+	SaveMember(context.Context, string, *dto.OrganizationMemberRequest) error
+	RemoveMember(context.Context, string, string) error
+}
+
+func NewOrganizationService(repository repositories.IRepositoryRegistry) IOrganizationService {
+	return &OrganizationService{repository: repository}
+}
+
+func toOrganizationResponse(organization *models.Organization) *dto.OrganizationResponse {
+	return &dto.OrganizationResponse{
+		UUID:      organization.UUID,
+		Code:      organization.Code,
+		Name:      organization.Name,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}
+}
+
+func (s *OrganizationService) Create(ctx context.Context, req *dto.OrganizationRequest) (*dto.OrganizationResponse, error) {
+	existing, err := s.repository.GetOrganization().FindByCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errConstant.ErrOrganizationCodeExist
+	}
+
+	organization, err := s.repository.GetOrganization().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toOrganizationResponse(organization), nil
+}
+
+func (s *OrganizationService) Update(ctx context.Context, req *dto.OrganizationRequest, uuid string) (*dto.OrganizationResponse, error) {
+	existing, err := s.repository.GetOrganization().FindByCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.UUID.String() != uuid {
+		return nil, errConstant.ErrOrganizationCodeExist
+	}
+
+	organization, err := s.repository.GetOrganization().Update(ctx, req, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toOrganizationResponse(organization), nil
+}
+
+func (s *OrganizationService) GetAll(ctx context.Context) ([]dto.OrganizationResponse, error) {
+	organizations, err := s.repository.GetOrganization().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.OrganizationResponse, 0, len(organizations))
+	for _, organization := range organizations {
+		data = append(data, *toOrganizationResponse(&organization))
+	}
+	return data, nil
+}
+
+func (s *OrganizationService) GetByUUID(ctx context.Context, uuid string) (*dto.OrganizationResponse, error) {
+	organization, err := s.repository.GetOrganization().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return toOrganizationResponse(organization), nil
+}
+
+func (s *OrganizationService) GetMembers(ctx context.Context, uuid string) ([]dto.OrganizationMemberResponse, error) {
+	organization, err := s.repository.GetOrganization().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repository.GetOrganization().FindMembers(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// satu user bisa memiliki beberapa baris keanggotaan, satu untuk tiap role
+	data := []dto.OrganizationMemberResponse{}
+	index := map[uint]int{}
+	for _, member := range members {
+		i, ok := index[member.UserID]
+		if !ok {
+			i = len(data)
+			index[member.UserID] = i
+			data = append(data, dto.OrganizationMemberResponse{
+				UUID:     member.User.UUID,
+				Name:     member.User.Name,
+				Username: member.User.Username,
+				Email:    member.User.Email,
+				Roles:    []string{},
+			})
+		}
+		data[i].Roles = append(data[i].Roles, strings.ToLower(member.Role.Code))
+	}
+	return data, nil
+}
+
+func (s *OrganizationService) SaveMember(ctx context.Context, uuid string, req *dto.OrganizationMemberRequest) error {
+	organization, err := s.repository.GetOrganization().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	user, err := s.repository.GetUser().FindByUUID(ctx, req.UserUUID)
+	if err != nil {
+		return err
+	}
+	roles, err := s.repository.GetRole().FindByCodes(ctx, req.Roles)
+	if err != nil {
+		return err
+	}
+
+	before, err := s.memberRoles(ctx, organization.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	roleIDs := make([]uint, 0, len(roles))
+	after := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID)
+		after = append(after, strings.ToLower(role.Code))
+	}
+	slices.Sort(after)
+	return s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		// anggota ikut dicari pada lookup ber-tenant, sehingga tidak boleh berbagi username
+		// atau email dengan user lain di organisasi tersebut
+		if err := repository.GetUser().CheckTenantConflict(ctx, user, organization.ID); err != nil {
+			return err
+		}
+		if err := repository.GetOrganization().ReplaceMember(ctx, organization.ID, user.ID, roleIDs); err != nil {
+			return err
+		}
+		return recordMemberChanges(ctx, repository, constants.AuditMemberSaved, organization, user, before, after)
+	})
+}
+
+func (s *OrganizationService) RemoveMember(ctx context.Context, uuid, userUUID string) error {
+	organization, err := s.repository.GetOrganization().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	user, err := s.repository.GetUser().FindByUUID(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+	before, err := s.memberRoles(ctx, organization.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	return s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetOrganization().DeleteMember(ctx, organization.ID, user.ID); err != nil {
+			return err
+		}
+		return recordMemberChanges(ctx, repository, constants.AuditMemberRemoved, organization, user, before, []string{})
+	})
+}
+
+// memberRoles mengambil kode role user pada organisasi, terurut
+func (s *OrganizationService) memberRoles(ctx context.Context, organizationID, userID uint) ([]string, error) {
+	members, err := s.repository.GetOrganization().FindMembers(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	roles := []string{}
+	for _, member := range members {
+		if member.UserID == userID {
+			roles = append(roles, strings.ToLower(member.Role.Code))
+		}
+	}
+	slices.Sort(roles)
+	return roles, nil
+}
+
+// recordMemberChanges mencatat perubahan role keanggotaan user ke audit log memakai
+// repository yang diberikan
+func recordMemberChanges(
+	ctx context.Context,
+	repository repositories.IRepositoryRegistry,
+	action string,
+	organization *models.Organization,
+	user *models.User,
+	before, after []string,
+) error {
+	changes := auditServices.Diff(map[string]any{"roles": before}, map[string]any{"roles": after})
+	return repository.GetAudit().RecordChanges(ctx, action, constants.SubjectUser, &user.UUID, changes, map[string]any{
+		"organization": organization.UUID,
+	})
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	eventClient "user-service/clients/event"
+	"user-service/constants"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/sirupsen/logrus"
+)
+
+type OutboxService struct {
+	repository repositories.IRepositoryRegistry
+	publisher  eventClient.IPublisher
+}
+
+type IOutboxService interface {
+	Deliver(context.Context) error
+}
+
+func NewOutboxService(repository repositories.IRepositoryRegistry, publisher eventClient.IPublisher) IOutboxService {
+	return &OutboxService{repository: repository, publisher: publisher}
+}
+
+// Enqueue menyimpan event ke outbox memakai repository yang diberikan. Panggil dengan
+// registry transaksi agar event hanya tersimpan jika perubahan datanya ikut tersimpan.
+func Enqueue(ctx context.Context, repository repositories.IRepositoryRegistry, event *eventClient.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return repository.GetOutbox().Enqueue(ctx, &models.OutboxEvent{
+		EventID:       event.ID,
+		Type:          event.Type,
+		Payload:       string(payload),
+		NextAttemptAt: event.OccurredAt,
+	})
+}
+
+// Deliver mengirim event outbox yang sudah waktunya. Event yang gagal dicoba lagi dengan
+// jeda yang berlipat sampai OutboxMaxBackoffSecond. Pengiriman bersifat at-least-once,
+// sehingga penerima memakai ID event untuk mengabaikan duplikat.
+func (s *OutboxService) Deliver(ctx context.Context) error {
+	events, err := s.repository.GetOutbox().FindPending(ctx, time.Now(), constants.OutboxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range events {
+		event := &eventClient.Event{}
+		err = json.Unmarshal([]byte(outbox.Payload), event)
+		if err == nil {
+			err = s.publisher.Publish(ctx, event)
+		}
+		if err == nil {
+			if err = s.repository.GetOutbox().MarkDelivered(ctx, &outbox); err != nil {
+				return err
+			}
+			continue
+		}
+
+		logrus.Errorf("failed to publish event %s %s (attempt %d): %v", outbox.Type, outbox.EventID, outbox.Attempts+1, err)
+		nextAttemptAt := time.Now().Add(Backoff(outbox.Attempts + 1))
+		if err = s.repository.GetOutbox().MarkFailed(ctx, &outbox, err.Error(), nextAttemptAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Backoff menghitung jeda sebelum percobaan berikutnya setelah sejumlah percobaan gagal
+func Backoff(attempts int) time.Duration {
+	limit := time.Duration(constants.OutboxMaxBackoffSecond) * time.Second
+	delay := time.Second
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= limit {
+			return limit
+		}
+	}
+	return delay
+}
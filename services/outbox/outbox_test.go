@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	eventClient "user-service/clients/event"
+	"user-service/database/dbtest"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+type fakePublisher struct {
+	err       error
+	published []*eventClient.Event
+}
+
+func (p *fakePublisher) Publish(_ context.Context, event *eventClient.Event) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, event)
+	return nil
+}
+
+func TestEnqueueIsDiscardedWithItsTransaction(t *testing.T) {
+	repository := repositories.NewRepositoryRegistry(dbtest.Open(t))
+	ctx := context.Background()
+
+	rollback := errors.New("rollback")
+	err := repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := Enqueue(ctx, repository, eventClient.NewEvent("user.deleted", uuid.New(), nil)); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("Transaction = %v, want rollback", err)
+	}
+	pending, err := repository.GetOutbox().FindPending(ctx, time.Now(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("pending events = %d after rollback, want 0", len(pending))
+	}
+}
+
+func TestDeliverRetriesUntilPublished(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := repositories.NewRepositoryRegistry(db)
+	ctx := context.Background()
+
+	event := eventClient.NewEvent("user.deletion.scheduled", uuid.New(), map[string]any{"scheduledAt": "2026-11-18"})
+	if err := Enqueue(ctx, repository, event); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+
+	publisher := &fakePublisher{err: errors.New("webhook down")}
+	service := NewOutboxService(repository, publisher)
+	if err := service.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	var stored models.OutboxEvent
+	db.First(&stored)
+	if stored.Attempts != 1 || stored.DeliveredAt != nil || stored.LastError != "webhook down" {
+		t.Fatalf("after failure: attempts=%d delivered=%v error=%q", stored.Attempts, stored.DeliveredAt, stored.LastError)
+	}
+	if !stored.NextAttemptAt.After(time.Now()) {
+		t.Errorf("next attempt %v is not in the future", stored.NextAttemptAt)
+	}
+
+	// percobaan ulang dijalankan setelah jedanya lewat
+	db.Model(&stored).Update("next_attempt_at", time.Now().Add(-time.Second))
+	publisher.err = nil
+	if err := service.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	if len(publisher.published) != 1 || publisher.published[0].ID != event.ID || publisher.published[0].Type != event.Type {
+		t.Fatalf("published = %+v, want event %s", publisher.published, event.ID)
+	}
+	db.First(&stored)
+	if stored.Attempts != 2 || stored.DeliveredAt == nil {
+		t.Errorf("after delivery: attempts=%d delivered=%v", stored.Attempts, stored.DeliveredAt)
+	}
+
+	// event yang sudah terkirim tidak dikirim lagi
+	if err := service.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	if len(publisher.published) != 1 {
+		t.Errorf("event published %d times, want once", len(publisher.published))
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 5: 16 * time.Second, 40: time.Hour}
+	for attempts, want := range tests {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
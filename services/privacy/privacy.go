@@ -0,0 +1,382 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	eventClient "user-service/clients/event"
+	storageClient "user-service/clients/storage"
+	"user-service/common/i18n"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	privacyRepo "user-service/repositories/privacy"
+	auditServices "user-service/services/audit"
+	outboxServices "user-service/services/outbox"
+	userServices "user-service/services/user"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PrivacyService struct {
+	repository repositories.IRepositoryRegistry
+	storage    storageClient.IStorage
+}
+
+type IPrivacyService interface {
+	Export(context.Context) (*dto.ExportFile, error)
+	RequestErasure(context.Context, *dto.ErasureRequest) (*dto.ErasureResponse, error)
+	DeleteAccount(context.Context, *dto.ErasureRequest) (*dto.ErasureResponse, error)
+	GetErasure(context.Context) (*dto.ErasureResponse, error)
+	CancelErasure(context.Context) (*dto.ErasureResponse, error)
+	ProcessErasures(context.Context) error
+}
+
+func NewPrivacyService(repository repositories.IRepositoryRegistry, storage storageClient.IStorage) IPrivacyService {
+	return &PrivacyService{repository: repository, storage: storage}
+}
+
+func toErasureResponse(request *models.ErasureRequest) *dto.ErasureResponse {
+	return &dto.ErasureResponse{
+		UUID:        request.UUID,
+		Status:      request.Status,
+		ScheduledAt: request.ScheduledAt,
+		CancelledAt: request.CancelledAt,
+		CompletedAt: request.CompletedAt,
+		CreatedAt:   request.CreatedAt,
+	}
+}
+
+// currentUser mengambil model user yang sedang login
+func (s *PrivacyService) currentUser(ctx context.Context) (*models.User, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+}
+
+// exportSections menyusun isi arsip ekspor; setiap section menjadi satu file JSON
+func exportSections(data *privacyRepo.UserData) map[string]any {
+	user := data.User
+	profile := map[string]any{
+		"uuid":            user.UUID,
+		"name":            user.Name,
+		"username":        user.Username,
+		"email":           user.Email,
+		"phoneNumber":     user.PhoneNumber,
+		"status":          user.Status,
+		"suspendedReason": user.SuspendedReason,
+		"suspendedUntil":  user.SuspendedUntil,
+		"avatar":          storageClient.URL(user.AvatarKey),
+		"attributes":      user.Attributes,
+		"emailVerifiedAt": user.EmailVerifiedAt,
+		"lastLoginAt":     user.LastLoginAt,
+		"createdAt":       user.CreatedAt,
+		"updatedAt":       user.UpdatedAt,
+	}
+	if user.Organization != nil {
+		profile["organization"] = user.Organization.Code
+	}
+
+	roles := []map[string]any{}
+	for _, userRole := range user.UserRoles {
+		roles = append(roles, map[string]any{
+			"role":      strings.ToLower(userRole.Role.Code),
+			"startsAt":  userRole.StartsAt,
+			"expiresAt": userRole.ExpiresAt,
+			"grantedAt": userRole.CreatedAt,
+		})
+	}
+	memberships := []map[string]any{}
+	for _, membership := range data.Memberships {
+		memberships = append(memberships, map[string]any{
+			"organization": membership.Organization.Code,
+			"role":         strings.ToLower(membership.Role.Code),
+			"joinedAt":     membership.CreatedAt,
+		})
+	}
+	groups := []map[string]any{}
+	for _, group := range data.Groups {
+		groups = append(groups, map[string]any{
+			"uuid": group.UUID,
+			"name": group.Name,
+		})
+	}
+	elevations := []map[string]any{}
+	for _, elevation := range data.Elevations {
+		elevations = append(elevations, map[string]any{
+			"uuid":            elevation.UUID,
+			"role":            strings.ToLower(elevation.Role.Code),
+			"reason":          elevation.Reason,
+			"durationMinutes": elevation.DurationMinutes,
+			"status":          elevation.Status,
+			"startsAt":        elevation.StartsAt,
+			"expiresAt":       elevation.ExpiresAt,
+			"createdAt":       elevation.CreatedAt,
+		})
+	}
+	statusHistory := []map[string]any{}
+	for _, history := range data.StatusHistory {
+		statusHistory = append(statusHistory, map[string]any{
+			"fromStatus": history.FromStatus,
+			"toStatus":   history.ToStatus,
+			"reason":     history.Reason,
+			"until":      history.Until,
+			"createdAt":  history.CreatedAt,
+		})
+	}
+	auditLogs := []dto.AuditLogResponse{}
+	for _, auditLog := range data.AuditLogs {
+		auditLogs = append(auditLogs, auditServices.ToAuditLogResponse(&auditLog))
+	}
+	emailChanges := []map[string]any{}
+	for _, change := range data.EmailChanges {
+		emailChanges = append(emailChanges, map[string]any{
+			"uuid":        change.UUID,
+			"newEmail":    change.NewEmail,
+			"status":      change.Status,
+			"expiresAt":   change.ExpiresAt,
+			"confirmedAt": change.ConfirmedAt,
+			"cancelledAt": change.CancelledAt,
+			"createdAt":   change.CreatedAt,
+		})
+	}
+	logins := []map[string]any{}
+	for _, attempt := range data.LoginAttempts {
+		logins = append(logins, map[string]any{
+			"uuid":          attempt.UUID,
+			"method":        attempt.Method,
+			"success":       attempt.Success,
+			"failureReason": attempt.FailureReason,
+			"ipAddress":     attempt.IPAddress,
+			"userAgent":     attempt.UserAgent,
+			"device":        attempt.Device,
+			"createdAt":     attempt.CreatedAt,
+		})
+	}
+	invitations := []map[string]any{}
+	for _, invitation := range data.Invitations {
+		item := map[string]any{
+			"uuid":       invitation.UUID,
+			"email":      invitation.Email,
+			"name":       invitation.Name,
+			"role":       strings.ToLower(invitation.Role.Code),
+			"status":     invitation.Status,
+			"expiresAt":  invitation.ExpiresAt,
+			"acceptedAt": invitation.AcceptedAt,
+			"revokedAt":  invitation.RevokedAt,
+			"createdAt":  invitation.CreatedAt,
+		}
+		if invitation.Organization != nil {
+			item["organization"] = invitation.Organization.Code
+		}
+		invitations = append(invitations, item)
+	}
+	erasures := []dto.ErasureResponse{}
+	for _, erasure := range data.Erasures {
+		erasures = append(erasures, *toErasureResponse(&erasure))
+	}
+
+	return map[string]any{
+		"profile":          profile,
+		"roles":            roles,
+		"memberships":      memberships,
+		"groups":           groups,
+		"role_elevations":  elevations,
+		"status_history":   statusHistory,
+		"audit_logs":       auditLogs,
+		"erasure_requests": erasures,
+		"email_changes":    emailChanges,
+		"logins":           logins,
+		"invitations":      invitations,
+	}
+}
+
+// Export menghasilkan arsip zip berisi seluruh data milik user yang sedang login
+func (s *PrivacyService) Export(ctx context.Context) (*dto.ExportFile, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.repository.GetPrivacy().FindUserData(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+	location := i18n.Location(ctx)
+	for name, section := range exportSections(data) {
+		file, err := archive.Create(name + ".json")
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(i18n.InLocation(section, location)); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetAudit().Record(ctx, constants.AuditDataExported, constants.SubjectUser, &user.UUID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ExportFile{
+		Name:        fmt.Sprintf("user-data-%s.zip", user.UUID),
+		ContentType: "application/zip",
+		Content:     buffer.Bytes(),
+	}, nil
+}
+
+func (s *PrivacyService) RequestErasure(ctx context.Context, req *dto.ErasureRequest) (*dto.ErasureResponse, error) {
+	return s.requestErasure(ctx, req, false)
+}
+
+// DeleteAccount menjadwalkan penghapusan akun milik user login lalu mencabut seluruh
+// tokennya. User masih bisa login kembali dan membatalkan selama masa tenggang.
+func (s *PrivacyService) DeleteAccount(ctx context.Context, req *dto.ErasureRequest) (*dto.ErasureResponse, error) {
+	return s.requestErasure(ctx, req, true)
+}
+
+// requestErasure membuat permintaan erasure beserta event outbox-nya. Jika revoke bernilai
+// true, token user dicabut dalam transaksi yang sama sehingga erasure tidak pernah tersimpan
+// dengan token yang masih berlaku.
+func (s *PrivacyService) requestErasure(ctx context.Context, req *dto.ErasureRequest, revoke bool) (*dto.ErasureResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, errConstant.ErrPasswordIncorrect
+	}
+
+	pending, err := s.repository.GetPrivacy().FindPendingErasure(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return nil, errConstant.ErrErasureExist
+	}
+
+	graceDays := config.Config.ErasureGraceDays
+	if graceDays <= 0 {
+		graceDays = constants.DefaultErasureGraceDays
+	}
+	scheduledAt := time.Now().AddDate(0, 0, graceDays)
+	var request *models.ErasureRequest
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		request, err = repository.GetPrivacy().CreateErasure(ctx, user.ID, scheduledAt)
+		if err != nil {
+			return err
+		}
+		err = repository.GetAudit().Record(ctx, constants.AuditErasureRequested, constants.SubjectUser, &user.UUID, map[string]any{
+			"erasureRequest": request.UUID,
+			"scheduledAt":    request.ScheduledAt,
+		})
+		if err != nil {
+			return err
+		}
+		err = outboxServices.Enqueue(ctx, repository, eventClient.NewEvent(constants.EventUserDeletionScheduled, user.UUID, map[string]any{
+			"scheduledAt": request.ScheduledAt,
+		}))
+		if err != nil || !revoke {
+			return err
+		}
+
+		return userServices.RevokeTokens(ctx, repository, user, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toErasureResponse(request), nil
+}
+
+func (s *PrivacyService) GetErasure(ctx context.Context) (*dto.ErasureResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := s.repository.GetPrivacy().FindPendingErasure(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, errConstant.ErrErasureNotFound
+	}
+	return toErasureResponse(request), nil
+}
+
+func (s *PrivacyService) CancelErasure(ctx context.Context) (*dto.ErasureResponse, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := s.repository.GetPrivacy().FindPendingErasure(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, errConstant.ErrErasureNotFound
+	}
+
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetPrivacy().CancelErasure(ctx, request); err != nil {
+			return err
+		}
+		err := repository.GetAudit().Record(ctx, constants.AuditErasureCancelled, constants.SubjectUser, &user.UUID, map[string]any{
+			"erasureRequest": request.UUID,
+		})
+		if err != nil {
+			return err
+		}
+		return outboxServices.Enqueue(ctx, repository, eventClient.NewEvent(constants.EventUserDeletionCancelled, user.UUID, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toErasureResponse(request), nil
+}
+
+// ProcessErasures menganonimkan user yang masa tenggang penghapusannya sudah habis
+func (s *PrivacyService) ProcessErasures(ctx context.Context) error {
+	requests, err := s.repository.GetPrivacy().FindDueErasures(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, request := range requests {
+		avatarKey := request.User.AvatarKey
+		err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+			if err := repository.GetPrivacy().Erase(ctx, &request); err != nil {
+				return err
+			}
+			err := repository.GetAudit().Record(ctx, constants.AuditErasureCompleted, constants.SubjectUser, &request.User.UUID, map[string]any{
+				"erasureRequest": request.UUID,
+			})
+			if err != nil {
+				return err
+			}
+			return outboxServices.Enqueue(ctx, repository, eventClient.NewEvent(constants.EventUserDeleted, request.User.UUID, nil))
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range userServices.AvatarKeys(avatarKey) {
+			if err = s.storage.Delete(ctx, key); err != nil {
+				logrus.Errorf("failed to delete avatar object %s: %v", key, err)
+			}
+		}
+		logrus.Infof("erasure request %s completed", request.UUID)
+	}
+	return nil
+}
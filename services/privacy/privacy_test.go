@@ -0,0 +1,190 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func loginAs(t *testing.T, db *gorm.DB, password string) (context.Context, *models.User) {
+	t.Helper()
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := repositories.NewRepositoryRegistry(db).GetUser().Register(context.Background(), &dto.RegisterRequest{
+		Name:        "Alice",
+		Username:    "alice",
+		Email:       "alice@example.com",
+		Password:    string(hashed),
+		PhoneNumber: "0800",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: user.UUID})
+	return ctx, user
+}
+
+func TestDeleteAccountRevokesTokensAndQueuesEvent(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewPrivacyService(repositories.NewRepositoryRegistry(db), nil)
+	ctx, user := loginAs(t, db, "secret")
+
+	if _, err := service.DeleteAccount(ctx, &dto.ErasureRequest{Password: "secret"}); err != nil {
+		t.Fatalf("DeleteAccount: %v", err)
+	}
+
+	var stored models.User
+	db.First(&stored, user.ID)
+	if stored.TokensRevokedAt == nil || stored.TokensRevokedAt.After(time.Now()) {
+		t.Errorf("tokens_revoked_at = %v, want a time up to now", stored.TokensRevokedAt)
+	}
+	var events []models.OutboxEvent
+	db.Find(&events)
+	if len(events) != 1 || events[0].Type != constants.EventUserDeletionScheduled || events[0].DeliveredAt != nil {
+		t.Errorf("outbox = %+v, want one pending %s event", events, constants.EventUserDeletionScheduled)
+	}
+	var actions []string
+	db.Model(&models.AuditLog{}).Order("id").Pluck("action", &actions)
+	want := []string{constants.AuditErasureRequested, constants.AuditTokensRevoked}
+	if !slices.Equal(actions, want) {
+		t.Errorf("audit actions = %v, want %v", actions, want)
+	}
+}
+
+func TestDeleteAccountStoresNothingWhenItFails(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewPrivacyService(repositories.NewRepositoryRegistry(db), nil)
+	ctx, user := loginAs(t, db, "secret")
+
+	// tabel outbox yang hilang membuat transaksi gagal setelah erasure dibuat
+	if err := db.Migrator().DropTable("outbox_events"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.DeleteAccount(ctx, &dto.ErasureRequest{Password: "secret"}); err == nil {
+		t.Fatal("DeleteAccount succeeded without an outbox table")
+	}
+
+	var erasures int64
+	db.Model(&models.ErasureRequest{}).Count(&erasures)
+	var stored models.User
+	db.First(&stored, user.ID)
+	if erasures != 0 || stored.TokensRevokedAt != nil {
+		t.Errorf("after a failed DeleteAccount: %d erasure requests, tokens_revoked_at %v", erasures, stored.TokensRevokedAt)
+	}
+
+	if _, err := service.DeleteAccount(ctx, &dto.ErasureRequest{Password: "wrong"}); !errors.Is(err, errConstant.ErrPasswordIncorrect) {
+		t.Errorf("DeleteAccount with a wrong password = %v, want ErrPasswordIncorrect", err)
+	}
+}
+
+func TestRequestErasureDefaultsToAGracePeriod(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewPrivacyService(repositories.NewRepositoryRegistry(db), nil)
+	ctx, _ := loginAs(t, db, "secret")
+
+	request, err := service.RequestErasure(ctx, &dto.ErasureRequest{Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Now().AddDate(0, 0, constants.DefaultErasureGraceDays)
+	if request.ScheduledAt.Before(want.Add(-time.Minute)) {
+		t.Errorf("erasure scheduled at %v, want about %v", request.ScheduledAt, want)
+	}
+	if err := service.ProcessErasures(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.GetErasure(ctx); err != nil {
+		t.Errorf("erasure inside the grace period was processed: %v", err)
+	}
+}
+
+func TestErasureCoversInvitations(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewPrivacyService(repositories.NewRepositoryRegistry(db), nil)
+	ctx, user := loginAs(t, db, "secret")
+	role := models.Role{Code: "VIEWER", Name: "Viewer"}
+	if err := db.Create(&role).Error; err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	invitations := []models.Invitation{
+		{UUID: uuid.New(), Email: user.Email, Name: user.Name, RoleID: role.ID, TokenHash: "accepted",
+			Status: constants.InvitationAccepted, ExpiresAt: now, UserID: &user.ID, AcceptedAt: &now},
+		{UUID: uuid.New(), Email: "ALICE@example.com", Name: "Alice", RoleID: role.ID, TokenHash: "pending",
+			Status: constants.InvitationPending, ExpiresAt: now.Add(time.Hour)},
+		{UUID: uuid.New(), Email: "carol@example.com", Name: "Carol", RoleID: role.ID, TokenHash: "other",
+			Status: constants.InvitationPending, ExpiresAt: now.Add(time.Hour)},
+	}
+	if err := db.Create(&invitations).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := service.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(file.Content), int64(len(file.Content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported []map[string]any
+	for _, entry := range archive.File {
+		if entry.Name != "invitations.json" {
+			continue
+		}
+		reader, err := entry.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.NewDecoder(reader).Decode(&exported); err != nil {
+			t.Fatal(err)
+		}
+		reader.Close()
+	}
+	if len(exported) != 2 {
+		t.Errorf("exported invitations = %v, want the two addressed to the user", exported)
+	}
+
+	if _, err := service.RequestErasure(ctx, &dto.ErasureRequest{Password: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	db.Model(&models.ErasureRequest{}).Where("user_id = ?", user.ID).Update("scheduled_at", now.Add(-time.Minute))
+	if err := service.ProcessErasures(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var stored []models.Invitation
+	db.Order("id").Find(&stored)
+	erasedEmail := user.UUID.String() + "@erased.invalid"
+	for _, invitation := range stored[:2] {
+		if invitation.Email != erasedEmail || invitation.Name != "Erased User" {
+			t.Errorf("invitation %s kept %q <%s> after erasure", invitation.TokenHash, invitation.Name, invitation.Email)
+		}
+	}
+	if stored[0].Status != constants.InvitationAccepted {
+		t.Errorf("accepted invitation status = %s, want it unchanged", stored[0].Status)
+	}
+	if stored[1].Status != constants.InvitationRevoked || stored[1].RevokedAt == nil {
+		t.Errorf("pending invitation status = %s revoked at %v, want it revoked", stored[1].Status, stored[1].RevokedAt)
+	}
+	if stored[2].Email != "carol@example.com" || stored[2].Status != constants.InvitationPending {
+		t.Errorf("unrelated invitation = %+v, want it untouched", stored[2])
+	}
+}
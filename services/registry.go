@@ -1,22 +1,90 @@
 package services
 
 import (
+	"user-service/clients"
 	"user-service/repositories"
+	accessReviewServices "user-service/services/access_review"
+	attributeServices "user-service/services/attribute"
+	auditServices "user-service/services/audit"
+	elevationServices "user-service/services/elevation"
+	emailChangeServices "user-service/services/email_change"
+	groupServices "user-service/services/group"
+	invitationServices "user-service/services/invitation"
+	orgServices "user-service/services/organization"
+	outboxServices "user-service/services/outbox"
+	privacyServices "user-service/services/privacy"
 	services "user-service/services/user"
+	importServices "user-service/services/user_import"
 )
 
 type Registry struct {
 	repository repositories.IRepositoryRegistry
+	client     clients.IClientRegistry
 }
 
 type IServiceRegistry interface {
 	GetUser() services.IUserService
+	GetOrganization() orgServices.IOrganizationService
+	GetGroup() groupServices.IGroupService
+	GetElevation() elevationServices.IElevationService
+	GetAccessReview() accessReviewServices.IAccessReviewService
+	GetPrivacy() privacyServices.IPrivacyService
+	GetAttribute() attributeServices.IAttributeService
+	GetImport() importServices.IImportService
+	GetEmailChange() emailChangeServices.IEmailChangeService
+	GetAudit() auditServices.IAuditService
+	GetInvitation() invitationServices.IInvitationService
+	GetOutbox() outboxServices.IOutboxService
 }
 
-func NewServiceRegistry(repository repositories.IRepositoryRegistry) IServiceRegistry {
-	return &Registry{repository: repository}
+func NewServiceRegistry(repository repositories.IRepositoryRegistry, client clients.IClientRegistry) IServiceRegistry {
+	return &Registry{repository: repository, client: client}
 }
 
 func (r *Registry) GetUser() services.IUserService {
-	return services.NewUserService(r.repository)
+	return services.NewUserService(r.repository, r.client.GetStorage())
+}
+
+func (r *Registry) GetOrganization() orgServices.IOrganizationService {
+	return orgServices.NewOrganizationService(r.repository)
+}
+
+func (r *Registry) GetGroup() groupServices.IGroupService {
+	return groupServices.NewGroupService(r.repository)
+}
+
+func (r *Registry) GetElevation() elevationServices.IElevationService {
+	return elevationServices.NewElevationService(r.repository)
+}
+
+func (r *Registry) GetAccessReview() accessReviewServices.IAccessReviewService {
+	return accessReviewServices.NewAccessReviewService(r.repository)
+}
+
+func (r *Registry) GetPrivacy() privacyServices.IPrivacyService {
+	return privacyServices.NewPrivacyService(r.repository, r.client.GetStorage())
+}
+
+func (r *Registry) GetAttribute() attributeServices.IAttributeService {
+	return attributeServices.NewAttributeService(r.repository)
+}
+
+func (r *Registry) GetImport() importServices.IImportService {
+	return importServices.NewImportService(r.repository)
+}
+
+func (r *Registry) GetEmailChange() emailChangeServices.IEmailChangeService {
+	return emailChangeServices.NewEmailChangeService(r.repository, r.client.GetMailer())
+}
+
+func (r *Registry) GetAudit() auditServices.IAuditService {
+	return auditServices.NewAuditService(r.repository)
+}
+
+func (r *Registry) GetInvitation() invitationServices.IInvitationService {
+	return invitationServices.NewInvitationService(r.repository, r.client.GetMailer())
+}
+
+func (r *Registry) GetOutbox() outboxServices.IOutboxService {
+	return outboxServices.NewOutboxService(r.repository, r.client.GetPublisher())
 }
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"user-service/constants"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	auditServices "user-service/services/audit"
+
+	"github.com/google/uuid"
+)
+
+// AuditSnapshot mengambil field user yang dibandingkan pada audit log.
+// Atribut profil diratakan menjadi attributes.<key> agar diff tetap per field.
+func AuditSnapshot(user *models.User) map[string]any {
+	snapshot := map[string]any{
+		"name":            user.Name,
+		"username":        user.Username,
+		"email":           user.Email,
+		"phoneNumber":     user.PhoneNumber,
+		"password":        user.Password,
+		"status":          user.Status,
+		"suspendedReason": user.SuspendedReason,
+		"suspendedUntil":  user.SuspendedUntil,
+		"avatarKey":       user.AvatarKey,
+		"locale":          user.Locale,
+		"timezone":        user.Timezone,
+		"tokensRevokedAt": user.TokensRevokedAt,
+	}
+	for key, value := range user.Attributes {
+		snapshot["attributes."+key] = value
+	}
+	return snapshot
+}
+
+// recordUserChanges mencatat diff dua snapshot user ke audit log memakai repository yang
+// diberikan; tanpa perubahan tidak ada entri
+func recordUserChanges(ctx context.Context, repository repositories.IRepositoryRegistry, action string, subject uuid.UUID, before, after map[string]any, metadata any) error {
+	changes := auditServices.Diff(before, after)
+	if len(changes) == 0 && metadata == nil {
+		return nil
+	}
+	return repository.GetAudit().RecordChanges(ctx, action, constants.SubjectUser, &subject, changes, metadata)
+}
+
+// RevokeTokens mencabut seluruh token user dan mencatatnya ke audit log memakai repository
+// yang diberikan. Panggil dengan registry transaksi agar pencabutan ikut tersimpan atau
+// batal bersama perubahan yang menyebabkannya.
+func RevokeTokens(ctx context.Context, repository repositories.IRepositoryRegistry, user *models.User, metadata any) error {
+	before := user.TokensRevokedAt
+	if err := repository.GetUser().RevokeTokens(ctx, user); err != nil {
+		return err
+	}
+	return repository.GetAudit().RecordChanges(ctx, constants.AuditTokensRevoked, constants.SubjectUser, &user.UUID, map[string]dto.AuditChange{
+		"tokensRevokedAt": {Before: before, After: user.TokensRevokedAt},
+	}, metadata)
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	storageClient "user-service/clients/storage"
+	"user-service/common/utils"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultAvatarMaxSizeKB = 2048
+
+// AvatarKeys mengembalikan key seluruh object avatar (original dan thumbnail)
+// berdasarkan key file original yang tersimpan di users.avatar_key
+func AvatarKeys(originalKey string) map[string]string {
+	if originalKey == "" {
+		return nil
+	}
+	keys := map[string]string{constants.AvatarOriginal: originalKey}
+	for name := range constants.AvatarSizes {
+		keys[name] = path.Join(path.Dir(originalKey), name+".jpg")
+	}
+	return keys
+}
+
+func toAvatar(originalKey string) *dto.Avatar {
+	keys := AvatarKeys(originalKey)
+	if keys == nil {
+		return nil
+	}
+	return &dto.Avatar{
+		Original: storageClient.URL(keys[constants.AvatarOriginal]),
+		Small:    storageClient.URL(keys[constants.AvatarSmall]),
+		Medium:   storageClient.URL(keys[constants.AvatarMedium]),
+		Large:    storageClient.URL(keys[constants.AvatarLarge]),
+	}
+}
+
+func avatarMaxSize() int64 {
+	if config.Config.AvatarMaxSizeKB <= 0 {
+		return defaultAvatarMaxSizeKB * 1024
+	}
+	return config.Config.AvatarMaxSizeKB * 1024
+}
+
+// readAvatar membaca file upload dengan batas ukuran lalu menentukan tipe kontennya
+// dari isi file, bukan dari header yang dikirim client
+func readAvatar(file *multipart.FileHeader) ([]byte, string, error) {
+	if file == nil {
+		return nil, "", errConstant.ErrAvatarRequired
+	}
+	maxSize := avatarMaxSize()
+	if file.Size > maxSize {
+		return nil, "", errConstant.ErrAvatarTooLarge
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, "", errConstant.ErrAvatarTooLarge
+	}
+
+	contentType := http.DetectContentType(content)
+	if _, ok := constants.AvatarContentTypes[contentType]; !ok {
+		return nil, "", errConstant.ErrAvatarInvalidType
+	}
+	return content, contentType, nil
+}
+
+// renderThumbnails men-decode gambar dan membuat thumbnail JPEG untuk setiap ukuran
+func renderThumbnails(content []byte) (map[string][]byte, error) {
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || imageConfig.Width*imageConfig.Height > constants.AvatarMaxPixels {
+		return nil, errConstant.ErrAvatarInvalidType
+	}
+	source, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, errConstant.ErrAvatarInvalidType
+	}
+
+	thumbnails := map[string][]byte{}
+	for name, size := range constants.AvatarSizes {
+		buffer := &bytes.Buffer{}
+		err = jpeg.Encode(buffer, utils.Thumbnail(source, size), &jpeg.Options{Quality: 85})
+		if err != nil {
+			return nil, err
+		}
+		thumbnails[name] = buffer.Bytes()
+	}
+	return thumbnails, nil
+}
+
+// deleteAvatar menghapus object avatar lama; kegagalan hanya dicatat karena
+// data user sudah tidak lagi merujuk ke object tersebut
+func deleteAvatar(ctx context.Context, storage storageClient.IStorage, originalKey string) {
+	for _, key := range AvatarKeys(originalKey) {
+		if err := storage.Delete(ctx, key); err != nil {
+			logrus.Errorf("failed to delete avatar object %s: %v", key, err)
+		}
+	}
+}
+
+func (s *UserService) UploadAvatar(ctx context.Context, file *multipart.FileHeader) (*dto.UserResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	content, contentType, err := readAvatar(file)
+	if err != nil {
+		return nil, err
+	}
+	thumbnails, err := renderThumbnails(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// setiap upload memakai prefix baru agar URL lama tidak tertahan di cache
+	prefix := fmt.Sprintf("avatars/%s/%s", user.UUID, uuid.New())
+	originalKey := fmt.Sprintf("%s/%s.%s", prefix, constants.AvatarOriginal, constants.AvatarContentTypes[contentType])
+	keys := AvatarKeys(originalKey)
+	if err = s.storage.Put(ctx, originalKey, content, contentType); err != nil {
+		return nil, err
+	}
+	for name, thumbnail := range thumbnails {
+		if err = s.storage.Put(ctx, keys[name], thumbnail, "image/jpeg"); err != nil {
+			deleteAvatar(ctx, s.storage, originalKey)
+			return nil, err
+		}
+	}
+
+	previousKey := user.AvatarKey
+	before := AuditSnapshot(user)
+	if err = s.repository.GetUser().UpdateAvatar(ctx, user, originalKey); err != nil {
+		deleteAvatar(ctx, s.storage, originalKey)
+		return nil, err
+	}
+	deleteAvatar(ctx, s.storage, previousKey)
+
+	user.AvatarKey = originalKey
+	err = recordUserChanges(ctx, s.repository, constants.AuditAvatarUpdated, user.UUID, before, AuditSnapshot(user), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(ctx, user), nil
+}
+
+func (s *UserService) DeleteAvatar(ctx context.Context) (*dto.UserResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+	if user.AvatarKey == "" {
+		return nil, errConstant.ErrAvatarNotFound
+	}
+
+	previousKey := user.AvatarKey
+	before := AuditSnapshot(user)
+	if err = s.repository.GetUser().UpdateAvatar(ctx, user, ""); err != nil {
+		return nil, err
+	}
+	deleteAvatar(ctx, s.storage, previousKey)
+
+	user.AvatarKey = ""
+	err = recordUserChanges(ctx, s.repository, constants.AuditAvatarDeleted, user.UUID, before, AuditSnapshot(user), nil)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(ctx, user), nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/common/i18n"
+	"user-service/common/utils"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+)
+
+var exportColumns = []string{
+	"uuid", "name", "username", "email", "phoneNumber", "roles", "status", "verified", "attributes", "createdAt",
+}
+
+// exportWriter menulis satu user per baris sesuai format export
+type exportWriter interface {
+	Write(*dto.UserResponse) error
+	Flush() error
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvExportWriter) Write(user *dto.UserResponse) error {
+	attributes := ""
+	if len(user.Attributes) > 0 {
+		encoded, err := json.Marshal(user.Attributes)
+		if err != nil {
+			return err
+		}
+		attributes = string(encoded)
+	}
+	createdAt := ""
+	if user.CreatedAt != nil {
+		createdAt = user.CreatedAt.Format(time.RFC3339)
+	}
+	return w.writer.Write([]string{
+		user.UUID.String(),
+		escapeFormula(user.Name),
+		escapeFormula(user.Username),
+		escapeFormula(user.Email),
+		escapeFormula(user.PhoneNumber),
+		strings.Join(user.Roles, "|"),
+		user.Status,
+		strconv.FormatBool(user.Verified),
+		escapeFormula(attributes),
+		createdAt,
+	})
+}
+
+// escapeFormula menambahkan ' di depan nilai yang diawali karakter formula agar
+// spreadsheet menampilkannya sebagai teks dan tidak menjalankannya (CSV injection)
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func (w *csvExportWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlExportWriter) Write(user *dto.UserResponse) error {
+	return w.encoder.Encode(user)
+}
+
+func (w *jsonlExportWriter) Flush() error {
+	return nil
+}
+
+func newExportWriter(format string, writer io.Writer) (exportWriter, error) {
+	switch format {
+	case constants.ExportFormatCSV:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.Write(exportColumns); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{writer: csvWriter}, nil
+	case constants.ExportFormatJSONL:
+		return &jsonlExportWriter{encoder: json.NewEncoder(writer)}, nil
+	default:
+		return nil, errConstant.ErrExportFormat
+	}
+}
+
+// maskUser menyamarkan kolom PII; atribut profil tidak ikut diekspor karena bisa berisi PII
+func maskUser(user *dto.UserResponse) {
+	user.Name = utils.MaskString(user.Name)
+	user.Email = utils.MaskEmail(user.Email)
+	user.PhoneNumber = utils.MaskPhone(user.PhoneNumber)
+	user.Attributes = nil
+}
+
+// Export menulis seluruh user yang cocok dengan filter listing ke writer secara bertahap.
+// Hash password tidak pernah ikut karena data dibentuk dari UserResponse.
+func (s *UserService) Export(ctx context.Context, writer io.Writer, req *dto.UserExportRequest) error {
+	if err := s.checkAttributeFilters(ctx, req.Attributes); err != nil {
+		return err
+	}
+	exporter, err := newExportWriter(req.Format, writer)
+	if err != nil {
+		return err
+	}
+
+	location := i18n.Location(ctx)
+	total := 0
+	err = s.repository.GetUser().FindInBatches(ctx, &req.UserListRequest, constants.ExportBatchSize, func(users []models.User) error {
+		for i := range users {
+			user := i18n.InLocation(toUserResponse(ctx, &users[i]), location).(*dto.UserResponse)
+			user.Avatar = nil
+			if req.Mask {
+				maskUser(user)
+			}
+			if err := exporter.Write(user); err != nil {
+				return err
+			}
+		}
+		total += len(users)
+		if err := exporter.Flush(); err != nil {
+			return err
+		}
+		// kirim ke client per batch agar response tidak tertahan di buffer
+		if flusher, ok := writer.(http.Flusher); ok {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetAudit().Record(ctx, constants.AuditUsersExported, constants.SubjectUser, nil, map[string]any{
+		"format": req.Format,
+		"masked": req.Mask,
+		"total":  total,
+	})
+}
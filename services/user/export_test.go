@@ -0,0 +1,51 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"user-service/constants"
+	"user-service/domain/dto"
+
+	"github.com/google/uuid"
+)
+
+func TestCSVExportEscapesFormulas(t *testing.T) {
+	var buffer bytes.Buffer
+	exporter, err := newExportWriter(constants.ExportFormatCSV, &buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = exporter.Write(&dto.UserResponse{
+		UUID:        uuid.New(),
+		Name:        `=HYPERLINK("http://evil.example","x")`,
+		Username:    "@sum",
+		Email:       "plain@example.com",
+		PhoneNumber: "+6281234",
+		Attributes:  map[string]any{"note": "-1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exporter.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := rows[1]
+	want := map[int]string{
+		1: `'=HYPERLINK("http://evil.example","x")`,
+		2: "'@sum",
+		3: "plain@example.com",
+		4: "'+6281234",
+		8: `{"note":"-1"}`,
+	}
+	for column, value := range want {
+		if row[column] != value {
+			t.Errorf("column %s = %q, want %q", exportColumns[column], row[column], value)
+		}
+	}
+}
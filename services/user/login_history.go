@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+	"user-service/common/response"
+	"user-service/common/utils"
+	"user-service/config"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// loginFailureReason menerjemahkan error login ke alasan yang disimpan pada riwayat login
+func loginFailureReason(user *models.User, err error) string {
+	switch {
+	case user == nil:
+		return constants.LoginFailedUnknownUser
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		return constants.LoginFailedInvalidPassword
+	case errors.Is(err, errConstant.ErrUserSuspended),
+		errors.Is(err, errConstant.ErrUserDeactivated),
+		errors.Is(err, errConstant.ErrUserDeleted):
+		return constants.LoginFailedUserStatus
+	default:
+		return constants.LoginFailedError
+	}
+}
+
+// recordLogin mencatat percobaan login. Kegagalan pencatatan hanya di-log agar tidak
+// menggagalkan login itu sendiri.
+func (s *UserService) recordLogin(ctx context.Context, username string, user *models.User, err error) {
+	ipAddress, _ := ctx.Value(constants.ClientIP).(string)
+	userAgent, _ := ctx.Value(constants.UserAgent).(string)
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+	if len(username) > 100 {
+		username = username[:100]
+	}
+	now := time.Now()
+	attempt := &models.LoginAttempt{
+		UUID:      uuid.New(),
+		Username:  username,
+		Method:    constants.LoginMethodPassword,
+		Success:   err == nil,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Device:    utils.DeviceSummary(userAgent),
+		CreatedAt: &now,
+	}
+	if user != nil {
+		attempt.UserID = &user.ID
+	}
+	if err != nil {
+		attempt.FailureReason = loginFailureReason(user, err)
+	}
+
+	if recordErr := s.repository.GetLoginHistory().Record(ctx, attempt); recordErr != nil {
+		logrus.Errorf("failed to record login attempt for %s: %v", username, recordErr)
+	}
+}
+
+func toLoginAttemptResponses(attempts []models.LoginAttempt) []dto.LoginAttemptResponse {
+	data := make([]dto.LoginAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		data = append(data, dto.LoginAttemptResponse{
+			UUID:          attempt.UUID,
+			Method:        attempt.Method,
+			Success:       attempt.Success,
+			FailureReason: attempt.FailureReason,
+			IPAddress:     attempt.IPAddress,
+			UserAgent:     attempt.UserAgent,
+			Device:        attempt.Device,
+			CreatedAt:     attempt.CreatedAt,
+		})
+	}
+	return data
+}
+
+// GetLoginHistory mengembalikan riwayat login milik user yang sedang login
+func (s *UserService) GetLoginHistory(
+	ctx context.Context,
+	req *dto.LoginHistoryRequest,
+) ([]dto.LoginAttemptResponse, *response.Pagination, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	return s.GetUserLoginHistory(ctx, userLogin.UUID.String(), req)
+}
+
+// GetUserLoginHistory mengembalikan riwayat login user tertentu, dipakai oleh admin
+func (s *UserService) GetUserLoginHistory(
+	ctx context.Context,
+	uuid string,
+	req *dto.LoginHistoryRequest,
+) ([]dto.LoginAttemptResponse, *response.Pagination, error) {
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, nil, err
+	}
+	attempts, pagination, err := s.repository.GetLoginHistory().FindByUser(ctx, user.ID, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toLoginAttemptResponses(attempts), pagination, nil
+}
+
+// PruneLoginHistory menghapus riwayat login yang melewati masa simpan
+func (s *UserService) PruneLoginHistory(ctx context.Context) error {
+	days := config.Config.LoginHistoryDays
+	if days <= 0 {
+		days = constants.DefaultLoginHistoryRetentionDays
+	}
+	deleted, err := s.repository.GetLoginHistory().Prune(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logrus.Infof("pruned %d login attempts older than %d days", deleted, days)
+	}
+	return nil
+}
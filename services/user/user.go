@@ -2,66 +2,157 @@ package services
 
 import (
 	"context"
+	"io"
+	"mime/multipart"
+	"slices"
 	"strings"
 	"time"
+	storageClient "user-service/clients/storage"
+	"user-service/common/response"
 	"user-service/config"
 	"user-service/constants"
 	errConstant "user-service/constants/error"
 	"user-service/domain/dto"
 	"user-service/domain/models"
 	"user-service/repositories"
+	attributeServices "user-service/services/attribute"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService struct {
 	repository repositories.IRepositoryRegistry
+	storage    storageClient.IStorage
 }
 
 type IUserService interface {
 	Login(context.Context, *dto.LoginRequest) (*dto.LoginResponse, error)
+	IssueToken(context.Context, *dto.TokenRequest) (*dto.LoginResponse, error)
 	Register(context.Context, *dto.RegisterRequest) (*dto.RegisterRespose, error)
 	Update(context.Context, *dto.UpdateUserRequest, string) (*dto.UserResponse, error)
+	Patch(context.Context, string, *dto.UserPatchRequest) (*dto.UserResponse, error)
 	GetUserLogin(context.Context) (*dto.UserResponse, error)
 	GetUserByUUID(context.Context, string) (*dto.UserResponse, error)
+	GetAll(context.Context, *dto.UserListRequest) ([]dto.UserResponse, *response.Pagination, error)
+	ChangeStatus(context.Context, string, *dto.UserStatusRequest) (*dto.UserResponse, error)
+	GetStatusHistory(context.Context, string) ([]dto.UserStatusHistoryResponse, error)
+	ReactivateExpiredSuspensions(context.Context) error
+	UploadAvatar(context.Context, *multipart.FileHeader) (*dto.UserResponse, error)
+	DeleteAvatar(context.Context) (*dto.UserResponse, error)
+	Export(context.Context, io.Writer, *dto.UserExportRequest) error
+	GetLoginHistory(context.Context, *dto.LoginHistoryRequest) ([]dto.LoginAttemptResponse, *response.Pagination, error)
+	GetUserLoginHistory(context.Context, string, *dto.LoginHistoryRequest) ([]dto.LoginAttemptResponse, *response.Pagination, error)
+	PruneLoginHistory(context.Context) error
 }
 
 type Claims struct {
-	User *dto.UserResponse
+	User         *dto.UserResponse
+	Organization *uuid.UUID  `json:"organization,omitempty"`
+	Groups       []uuid.UUID `json:"groups,omitempty"`
+	Scope        string      `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func NewUserService(repository repositories.IRepositoryRegistry) IUserService {
-	return &UserService{repository: repository}
+// toUserResponse memetakan model user beserta seluruh role-nya ke response.
+// Pada request ber-tenant, role yang dipakai adalah role user di tenant tersebut.
+func toUserResponse(ctx context.Context, user *models.User) *dto.UserResponse {
+	roles := []string{}
+	if _, ok := ctx.Value(constants.Tenant).(uuid.UUID); ok {
+		for _, membership := range user.Memberships {
+			roles = append(roles, strings.ToLower(membership.Role.Code))
+		}
+	} else {
+		for _, userRole := range user.UserRoles {
+			roles = append(roles, strings.ToLower(userRole.Role.Code))
+		}
+	}
+	return &dto.UserResponse{
+		UUID:        user.UUID,
+		Name:        user.Name,
+		Username:    user.Username,
+		Email:       user.Email,
+		PhoneNumber: user.PhoneNumber,
+		Roles:       roles,
+		Verified:    user.EmailVerifiedAt != nil,
+		Status:      user.Status,
+		Avatar:      toAvatar(user.AvatarKey),
+		Attributes:  user.Attributes,
+		Locale:      user.Locale,
+		Timezone:    user.Timezone,
+		LastLoginAt: user.LastLoginAt,
+		CreatedAt:   user.CreatedAt,
+		Version:     user.Version,
+	}
 }
 
-func (s *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
-	user, err := s.repository.GetUser().FindByUsername(ctx, req.Username)
-	if err != nil {
-		return nil, err
+// CheckUserStatus menolak user yang tidak aktif. Suspend yang sudah melewati
+// batas waktunya dianggap selesai meskipun belum dibersihkan oleh job.
+func CheckUserStatus(user *models.User) error {
+	if user.DeletedAt.Valid {
+		return errConstant.ErrUserDeleted
 	}
+	switch user.Status {
+	case constants.UserSuspended:
+		if user.SuspendedUntil == nil || user.SuspendedUntil.After(time.Now()) {
+			return errConstant.ErrUserSuspended
+		}
+	case constants.UserDeactivated:
+		return errConstant.ErrUserDeactivated
+	case constants.UserDeleted:
+		return errConstant.ErrUserDeleted
+	}
+	return nil
+}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
-	if err != nil {
-		return nil, err
+func NewUserService(repository repositories.IRepositoryRegistry, storage storageClient.IStorage) IUserService {
+	return &UserService{repository: repository, storage: storage}
+}
+
+// parseScope memecah scope yang diminta dan memastikan seluruhnya termasuk allowed.
+// Scope kosong berarti seluruh allowed.
+func parseScope(scope string, allowed []string) ([]string, error) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return slices.Clone(allowed), nil
+	}
+	for _, item := range requested {
+		if !slices.Contains(allowed, item) {
+			return nil, errConstant.ErrInvalidScope
+		}
 	}
+	slices.Sort(requested)
+	return slices.Compact(requested), nil
+}
 
-	expirationTime := time.Now().Add(time.Duration(config.Config.JwtExpireTime) * time.Minute).Unix()
-	data := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		PhoneNumber: user.PhoneNumber,
-		Email:       user.Email,
-		Role:        strings.ToLower(user.Role.Code),
+// generateToken membuat JWT untuk user dengan scope dan waktu kedaluwarsa tertentu
+func (s *UserService) generateToken(ctx context.Context, user *models.User, scopes []string, expiresAt time.Time) (*dto.LoginResponse, error) {
+	var err error
+	// token tidak boleh berlaku lebih lama dari role sementara yang dimiliki user
+	for _, userRole := range user.UserRoles {
+		if userRole.ExpiresAt != nil && userRole.ExpiresAt.Before(expiresAt) {
+			expiresAt = *userRole.ExpiresAt
+		}
 	}
+	data := toUserResponse(ctx, user)
 	claims := &Claims{
-		User: data,
+		User:  data,
+		Scope: strings.Join(scopes, " "),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Unix(expirationTime, 0)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 		},
 	}
+	if tenant, ok := ctx.Value(constants.Tenant).(uuid.UUID); ok {
+		claims.Organization = &tenant
+	}
+	if config.Config.JwtIncludeGroups {
+		claims.Groups, err = s.repository.GetGroup().FindUUIDsByUser(ctx, user.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString([]byte(config.Config.JwtSecretKey))
 	if err != nil {
@@ -75,6 +166,61 @@ func (s *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Lo
 	return response, nil
 }
 
+func (s *UserService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
+	scopes, err := parseScope(req.Scope, constants.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repository.GetUser().FindByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		s.recordLogin(ctx, req.Username, nil, errConstant.ErrUserNotFound)
+		return nil, errConstant.ErrUserNotFound
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+	if err == nil {
+		err = CheckUserStatus(user)
+	}
+	if err != nil {
+		s.recordLogin(ctx, req.Username, user, err)
+		return nil, err
+	}
+
+	expirationTime := time.Now().Add(time.Duration(config.Config.JwtExpireTime) * time.Minute)
+	data, err := s.generateToken(ctx, user, scopes, expirationTime)
+	s.recordLogin(ctx, req.Username, user, err)
+	return data, err
+}
+
+// IssueToken menerbitkan token baru dengan scope yang lebih sempit dari token saat ini,
+// misalnya token read-only untuk widget pihak ketiga
+func (s *UserService) IssueToken(ctx context.Context, req *dto.TokenRequest) (*dto.LoginResponse, error) {
+	var (
+		userLogin     = ctx.Value(constants.UserLogin).(*dto.UserResponse)
+		currentScopes = ctx.Value(constants.Scope).([]string)
+		expiresAt     = ctx.Value(constants.TokenExpiresAt).(time.Time)
+	)
+	scopes, err := parseScope(req.Scope, currentScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repository.GetUser().FindByUUID(ctx, userLogin.UUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	expirationTime := time.Now().Add(time.Duration(config.Config.JwtExpireTime) * time.Minute)
+	if expiresAt.Before(expirationTime) {
+		expirationTime = expiresAt
+	}
+	return s.generateToken(ctx, user, scopes, expirationTime)
+}
+
 func (s *UserService) isUserNameExist(ctx context.Context, username string) bool {
 	user, err := s.repository.GetUser().FindByUsername(ctx, username)
 	if err != nil {
@@ -96,18 +242,31 @@ func (s *UserService) isEmailExist(ctx context.Context, email string) bool {
 	}
 	return false
 }
+// Register adalah registrasi mandiri yang terbuka untuk publik, sehingga selalu membuat user
+// platform. Header tenant diabaikan: user tenant hanya masuk lewat undangan atau dibuat admin.
 func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*dto.RegisterRespose, error) {
+	ctx = context.WithValue(ctx, constants.Tenant, nil)
+
 	// cek password confirm dulu
 	if req.Password != req.ConfirmPassword {
 		return nil, errConstant.ErrPasswordDoesNotMatch
 	}
 
-	// cek username & email
-	if s.isUserNameExist(ctx, req.Username) {
-		return nil, errConstant.ErrUsernameExist
+	// cek username & email lebih dulu jika diaktifkan; tanpa pre-check keunikan tetap
+	// dijaga unique index dan diterjemahkan oleh repository
+	if config.Config.UniquePreCheck {
+		if s.isUserNameExist(ctx, req.Username) {
+			return nil, errConstant.ErrUsernameExist
+		}
+		if s.isEmailExist(ctx, req.Email) {
+			return nil, errConstant.ErrEmailExist
+		}
 	}
-	if s.isEmailExist(ctx, req.Email) {
-		return nil, errConstant.ErrEmailExist
+
+	// validasi atribut profil terhadap skema atribut
+	attributes, err := s.validateAttributes(ctx, nil, req.Attributes)
+	if err != nil {
+		return nil, err
 	}
 
 	// hash password
@@ -117,13 +276,23 @@ func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 	}
 
 	// register user
-	user, err := s.repository.GetUser().Register(ctx, &dto.RegisterRequest{
-		Name:        req.Name,
-		Username:    req.Username,
-		Password:    string(hashedPassword),
-		PhoneNumber: req.PhoneNumber,
-		Email:       req.Email,
-		RoleID:      constants.Customer,
+	var user *models.User
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		user, err = repository.GetUser().Register(ctx, &dto.RegisterRequest{
+			Name:        req.Name,
+			Username:    req.Username,
+			Password:    string(hashedPassword),
+			PhoneNumber: req.PhoneNumber,
+			Email:       req.Email,
+			Attributes:  attributes,
+			Locale:      req.Locale,
+			Timezone:    req.Timezone,
+			RoleIDs:     []uint{constants.Customer},
+		})
+		if err != nil {
+			return err
+		}
+		return recordUserChanges(ctx, repository, constants.AuditUserRegistered, user.UUID, nil, AuditSnapshot(user), nil)
 	})
 	if err != nil {
 		return nil, err
@@ -131,14 +300,7 @@ func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 
 	// buat response
 	response := &dto.RegisterRespose{
-		User: dto.UserResponse{
-			UUID:        user.UUID,
-			Name:        user.Name,
-			Username:    user.Username,
-			Email:       user.Email,
-			PhoneNumber: user.PhoneNumber,
-			Role:        user.Role.Name,
-		},
+		User: *toUserResponse(ctx, user),
 	}
 
 	return response, nil
@@ -146,18 +308,22 @@ func (s *UserService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 
 func (s *UserService) Update(ctx context.Context, req *dto.UpdateUserRequest, uuid string) (*dto.UserResponse, error) {
 	var (
-		password                  string
-		checkUsername, checkEmail *models.User
-		hashedPassword            []byte
-		user, userResult          *models.User
-		err                       error
-		data                      dto.UserResponse
+		password         *string
+		checkUsername    *models.User
+		hashedPassword   []byte
+		user, userResult *models.User
+		err              error
+		data             dto.UserResponse
 	)
 	user, err = s.repository.GetUser().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
-	isUsernameExist := s.isUserNameExist(ctx, req.Username)
+	if req.Version != nil && *req.Version != user.Version {
+		return nil, errConstant.ErrVersionConflict
+	}
+	before := AuditSnapshot(user)
+	isUsernameExist := config.Config.UniquePreCheck && s.isUserNameExist(ctx, req.Username)
 	if isUsernameExist && user.Username != req.Username {
 		checkUsername, err = s.repository.GetUser().FindByUsername(ctx, req.Username)
 		if err != nil {
@@ -167,33 +333,61 @@ func (s *UserService) Update(ctx context.Context, req *dto.UpdateUserRequest, uu
 			return nil, errConstant.ErrUsernameExist
 		}
 	}
-	isEmailExist := s.isEmailExist(ctx, req.Email)
-	if isEmailExist && user.Email != req.Email {
-		checkEmail, err = s.repository.GetUser().FindByEmail(ctx, req.Email)
-		if err != nil {
-			return nil, err
-		}
-		if checkEmail != nil {
-			return nil, errConstant.ErrEmailExist
-		}
+	// email hanya bisa diganti lewat alur penggantian email yang memverifikasi alamat baru
+	if !strings.EqualFold(user.Email, req.Email) {
+		return nil, errConstant.ErrEmailChangeRequired
+	}
+	attributes, err := s.validateAttributes(ctx, user.Attributes, req.Attributes)
+	if err != nil {
+		return nil, err
 	}
 	if req.Password != nil {
-		if *req.Password != *req.ConfirmPassword {
+		if req.ConfirmPassword == nil || *req.Password != *req.ConfirmPassword {
 			return nil, errConstant.ErrPasswordDoesNotMatch
 		}
 		hashedPassword, err = bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return nil, err
 		}
-		password = string(hashedPassword)
+		hashed := string(hashedPassword)
+		password = &hashed
 	}
-	userResult, err = s.repository.GetUser().Update(ctx, &dto.UpdateUserRequest{
-		Name:        req.Name,
-		Username:    req.Username,
-		Password:    &password,
-		PhoneNumber: req.PhoneNumber,
-		Email:       req.Email,
-	}, uuid)
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if !strings.EqualFold(user.Username, req.Username) {
+			candidate := *user
+			candidate.Username = req.Username
+			if err := repository.GetUser().CheckTenantConflict(ctx, &candidate); err != nil {
+				return err
+			}
+		}
+		userResult, err = repository.GetUser().Update(ctx, &dto.UpdateUserRequest{
+			Name:        req.Name,
+			Username:    req.Username,
+			Password:    password,
+			PhoneNumber: req.PhoneNumber,
+			Email:       user.Email,
+			Attributes:  attributes,
+			Locale:      req.Locale,
+			Timezone:    req.Timezone,
+			Version:     &user.Version,
+		}, uuid)
+		if err != nil {
+			return err
+		}
+
+		user.Name, user.Username, user.PhoneNumber, user.Attributes = req.Name, req.Username, req.PhoneNumber, attributes
+		// preferensi kosong berarti tidak diubah
+		if req.Locale != "" {
+			user.Locale = req.Locale
+		}
+		if req.Timezone != "" {
+			user.Timezone = req.Timezone
+		}
+		if password != nil {
+			user.Password = *password
+		}
+		return recordUserChanges(ctx, repository, constants.AuditUserUpdated, user.UUID, before, AuditSnapshot(user), nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -204,10 +398,127 @@ func (s *UserService) Update(ctx context.Context, req *dto.UpdateUserRequest, uu
 		Username:    userResult.Username,
 		Email:       userResult.Email,
 		PhoneNumber: userResult.PhoneNumber,
+		Locale:      user.Locale,
+		Timezone:    user.Timezone,
+		Version:     userResult.Version,
 	}
 	return &data, nil
 }
 
+// Patch menerapkan JSON Merge Patch; hanya field yang dikirim yang divalidasi dan disimpan
+func (s *UserService) Patch(ctx context.Context, uuid string, req *dto.UserPatchRequest) (*dto.UserResponse, error) {
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if req.Version != nil && *req.Version != user.Version {
+		return nil, errConstant.ErrVersionConflict
+	}
+	before := AuditSnapshot(user)
+
+	fields := []string{}
+	if req.Name != nil {
+		user.Name = *req.Name
+		fields = append(fields, "Name")
+	}
+	if req.Username != nil && *req.Username != user.Username {
+		if config.Config.UniquePreCheck {
+			existing, err := s.repository.GetUser().FindByUsername(ctx, *req.Username)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil && existing.ID != user.ID {
+				return nil, errConstant.ErrUsernameExist
+			}
+		}
+		user.Username = *req.Username
+		fields = append(fields, "Username")
+	}
+	// email hanya bisa diganti lewat alur penggantian email yang memverifikasi alamat baru
+	if req.Email != nil && !strings.EqualFold(user.Email, *req.Email) {
+		return nil, errConstant.ErrEmailChangeRequired
+	}
+	if req.PhoneNumber != nil {
+		user.PhoneNumber = *req.PhoneNumber
+		fields = append(fields, "PhoneNumber")
+	}
+	if req.Password != nil {
+		if req.ConfirmPassword == nil || *req.Password != *req.ConfirmPassword {
+			return nil, errConstant.ErrPasswordDoesNotMatch
+		}
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		user.Password = string(hashedPassword)
+		fields = append(fields, "Password")
+	}
+	if req.Locale != nil {
+		user.Locale = *req.Locale
+		fields = append(fields, "Locale")
+	}
+	if req.Timezone != nil {
+		user.Timezone = *req.Timezone
+		fields = append(fields, "Timezone")
+	}
+	if req.ClearAttributes || req.Attributes != nil {
+		current := user.Attributes
+		if req.ClearAttributes {
+			current = nil
+		}
+		attributes, err := s.validateAttributes(ctx, current, req.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		user.Attributes = attributes
+		fields = append(fields, "Attributes")
+	}
+
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if slices.Contains(fields, "Username") {
+			if err := repository.GetUser().CheckTenantConflict(ctx, user); err != nil {
+				return err
+			}
+		}
+		if err := repository.GetUser().Patch(ctx, user, fields); err != nil {
+			return err
+		}
+		return recordUserChanges(ctx, repository, constants.AuditUserUpdated, user.UUID, before, AuditSnapshot(user), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(ctx, user), nil
+}
+
+// checkAttributeFilters memastikan filter atribut hanya memakai atribut yang terdefinisi
+func (s *UserService) checkAttributeFilters(ctx context.Context, filters map[string]string) error {
+	for key := range filters {
+		definition, err := s.repository.GetAttribute().FindByKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		if definition == nil {
+			return errConstant.ErrAttributeNotFound
+		}
+	}
+	return nil
+}
+
+// validateAttributes menggabungkan perubahan atribut ke atribut lama lalu memvalidasi atribut
+// yang diubah terhadap skema
+func (s *UserService) validateAttributes(ctx context.Context, current, changes map[string]any) (map[string]any, error) {
+	definitions, err := s.repository.GetAttribute().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attributes := attributeServices.MergeAttributes(current, changes)
+	if err = attributeServices.ValidateChanges(definitions, attributes, changes); err != nil {
+		return nil, err
+	}
+	return attributes, nil
+}
+
 func (s *UserService) GetUserLogin(ctx context.Context) (*dto.UserResponse, error) {
 	var (
 		userLogin = ctx.Value(constants.UserLogin).(*dto.UserResponse)
@@ -219,7 +530,8 @@ func (s *UserService) GetUserLogin(ctx context.Context) (*dto.UserResponse, erro
 		Username:    userLogin.Username,
 		Email:       userLogin.Email,
 		PhoneNumber: userLogin.PhoneNumber,
-		Role:        userLogin.Role,
+		Roles:       userLogin.Roles,
+		Avatar:      userLogin.Avatar,
 	}
 	return &data, nil
 }
@@ -229,13 +541,111 @@ func (s *UserService) GetUserByUUID(ctx context.Context, uuid string) (*dto.User
 	if err != nil {
 		return nil, err
 	}
-	data := &dto.UserResponse{
-		UUID:        user.UUID,
-		Name:        user.Name,
-		Username:    user.Username,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
-		Role:        user.Role.Name,
+	return toUserResponse(ctx, user), nil
+}
+
+func (s *UserService) GetAll(ctx context.Context, req *dto.UserListRequest) ([]dto.UserResponse, *response.Pagination, error) {
+	if err := s.checkAttributeFilters(ctx, req.Attributes); err != nil {
+		return nil, nil, err
+	}
+
+	users, meta, err := s.repository.GetUser().FindAll(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make([]dto.UserResponse, 0, len(users))
+	for _, user := range users {
+		data = append(data, *toUserResponse(ctx, &user))
+	}
+	return data, meta, nil
+}
+
+func (s *UserService) ChangeStatus(ctx context.Context, uuid string, req *dto.UserStatusRequest) (*dto.UserResponse, error) {
+	userLogin := ctx.Value(constants.UserLogin).(*dto.UserResponse)
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if user.UUID == userLogin.UUID || !slices.Contains(constants.UserStatusTransitions[user.Status], req.Status) {
+		return nil, errConstant.ErrInvalidStatusChange
+	}
+	if req.Until != nil && (req.Status != constants.UserSuspended || !req.Until.After(time.Now())) {
+		return nil, errConstant.ErrInvalidStatusChange
+	}
+
+	changedBy, err := s.repository.GetUser().FindStatusByUUID(ctx, userLogin.UUID)
+	if err != nil {
+		return nil, err
+	}
+	fromStatus := user.Status
+	before := AuditSnapshot(user)
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		if err := repository.GetUser().ChangeStatus(ctx, user, req, &changedBy.ID); err != nil {
+			return err
+		}
+		// alasan hanya disimpan di riwayat status yang ikut dianonimkan saat erasure
+		return recordUserChanges(ctx, repository, constants.AuditUserStatusChanged, user.UUID, before, AuditSnapshot(user), map[string]any{
+			"from":  fromStatus,
+			"to":    req.Status,
+			"until": req.Until,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(ctx, user), nil
+}
+
+func (s *UserService) GetStatusHistory(ctx context.Context, uuid string) ([]dto.UserStatusHistoryResponse, error) {
+	user, err := s.repository.GetUser().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := s.repository.GetUser().FindStatusHistory(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]dto.UserStatusHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		item := dto.UserStatusHistoryResponse{
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			Reason:     history.Reason,
+			Until:      history.Until,
+			CreatedAt:  history.CreatedAt,
+		}
+		if history.ChangedBy != nil {
+			item.ChangedBy = &history.ChangedBy.UUID
+		}
+		data = append(data, item)
 	}
 	return data, nil
 }
+
+// ReactivateExpiredSuspensions mengaktifkan kembali user yang masa suspend-nya sudah berakhir
+func (s *UserService) ReactivateExpiredSuspensions(ctx context.Context) error {
+	users, err := s.repository.GetUser().FindExpiredSuspensions(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		req := &dto.UserStatusRequest{Status: constants.UserActive, Reason: "suspension period ended"}
+		before := AuditSnapshot(&user)
+		err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+			if err := repository.GetUser().ChangeStatus(ctx, &user, req, nil); err != nil {
+				return err
+			}
+			return recordUserChanges(ctx, repository, constants.AuditUserStatusChanged, user.UUID, before, AuditSnapshot(&user), map[string]any{
+				"from": constants.UserSuspended,
+				"to":   constants.UserActive,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
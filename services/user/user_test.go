@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"user-service/constants"
+	"user-service/database/dbtest"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+
+	"github.com/google/uuid"
+)
+
+func TestChangeStatusKeepsReasonOutOfAuditLog(t *testing.T) {
+	db := dbtest.Open(t)
+	repository := repositories.NewRepositoryRegistry(db)
+	service := NewUserService(repository, nil)
+	register := func(username string) *models.User {
+		user, err := repository.GetUser().Register(context.Background(), &dto.RegisterRequest{
+			Name: username, Username: username, Email: username + "@example.com", Password: "x", PhoneNumber: "0",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return user
+	}
+	alice, bob := register("alice"), register("bob")
+	ctx := context.WithValue(context.Background(), constants.UserLogin, &dto.UserResponse{UUID: alice.UUID})
+
+	reason := "bob told us about his divorce"
+	_, err := service.ChangeStatus(ctx, bob.UUID.String(), &dto.UserStatusRequest{Status: constants.UserSuspended, Reason: reason})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logs []models.AuditLog
+	db.Where("action = ?", constants.AuditUserStatusChanged).Find(&logs)
+	if len(logs) != 1 {
+		t.Fatalf("status change audit entries = %d, want 1", len(logs))
+	}
+	if strings.Contains(logs[0].Changes, reason) || strings.Contains(logs[0].Metadata, reason) {
+		t.Errorf("audit log kept the free-text reason: changes %s, metadata %s", logs[0].Changes, logs[0].Metadata)
+	}
+	var history models.UserStatusHistory
+	db.Where("user_id = ?", bob.ID).Last(&history)
+	if history.Reason != reason {
+		t.Errorf("status history reason = %q, want %q", history.Reason, reason)
+	}
+}
+
+func TestRegisterStoresNothingWhenAuditFails(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewUserService(repositories.NewRepositoryRegistry(db), nil)
+	if err := db.Migrator().DropTable("audit_logs"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := service.Register(context.Background(), &dto.RegisterRequest{
+		Name: "carol", Username: "carol", Email: "carol@example.com", Password: "secret", ConfirmPassword: "secret", PhoneNumber: "0",
+	})
+	if err == nil {
+		t.Fatal("Register succeeded without an audit table")
+	}
+	var users int64
+	db.Model(&models.User{}).Count(&users)
+	if users != 0 {
+		t.Errorf("users after a failed Register = %d, want 0", users)
+	}
+}
+
+func TestRegisterIgnoresTenant(t *testing.T) {
+	db := dbtest.Open(t)
+	service := NewUserService(repositories.NewRepositoryRegistry(db), nil)
+	if err := db.Create(&models.Role{ID: constants.Customer, Code: "CUSTOMER", Name: "Customer"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	organization := models.Organization{UUID: uuid.New(), Code: "ACME", Name: "Acme"}
+	if err := db.Create(&organization).Error; err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), constants.Tenant, organization.UUID)
+
+	response, err := service.Register(ctx, &dto.RegisterRequest{
+		Name: "carol", Username: "carol", Email: "carol@example.com", Password: "secret", ConfirmPassword: "secret", PhoneNumber: "0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var user models.User
+	db.First(&user, "uuid = ?", response.User.UUID)
+	if user.OrganizationID != nil {
+		t.Errorf("self-registered user belongs to organization %d, want a platform user", *user.OrganizationID)
+	}
+}
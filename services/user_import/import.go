@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	errWrap "user-service/common/error"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+	"user-service/repositories"
+	attributeServices "user-service/services/attribute"
+	auditServices "user-service/services/audit"
+	userServices "user-service/services/user"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ImportService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IImportService interface {
+	Import(context.Context, io.Reader, *dto.ImportRequest) (*dto.ImportReport, error)
+}
+
+func NewImportService(repository repositories.IRepositoryRegistry) IImportService {
+	return &ImportService{repository: repository}
+}
+
+// importJob menyimpan state satu kali import
+type importJob struct {
+	dryRun      bool
+	definitions []models.AttributeDefinition
+	validate    *validator.Validate
+	report      *dto.ImportReport
+	usernames   map[string]bool
+	emails      map[string]bool
+	batch       []*importRow
+}
+
+func (j *importJob) result(row *importRow, status, reason string) {
+	j.report.Rows = append(j.report.Rows, dto.ImportRowResult{
+		Row:      row.number,
+		Username: row.request.Username,
+		Email:    row.request.Email,
+		Status:   status,
+		Reason:   reason,
+	})
+	switch status {
+	case constants.ImportCreated:
+		j.report.Created++
+	case constants.ImportSkipped:
+		j.report.Skipped++
+	case constants.ImportFailed:
+		j.report.Failed++
+	}
+}
+
+// Import membaca user dari CSV atau JSON secara streaming, memvalidasi setiap baris
+// dengan aturan yang sama seperti register, lalu menyimpannya per batch dalam transaksi.
+// Baris yang username atau email-nya sudah ada dilewati (skipped).
+func (s *ImportService) Import(ctx context.Context, reader io.Reader, req *dto.ImportRequest) (*dto.ImportReport, error) {
+	definitions, err := s.repository.GetAttribute().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := newRowReader(req.Format, reader, definitions)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := req.BatchSize
+	if batchSize == 0 {
+		batchSize = constants.ImportDefaultBatchSize
+	}
+
+	job := &importJob{
+		dryRun:      req.DryRun,
+		definitions: definitions,
+		validate:    validator.New(),
+		report:      &dto.ImportReport{DryRun: req.DryRun, Rows: []dto.ImportRowResult{}},
+		usernames:   map[string]bool{},
+		emails:      map[string]bool{},
+	}
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		job.report.Total++
+
+		if reason := job.check(row); reason != "" {
+			job.result(row, constants.ImportFailed, reason)
+			continue
+		}
+		username, email := strings.ToLower(row.request.Username), strings.ToLower(row.request.Email)
+		if job.usernames[username] {
+			job.result(row, constants.ImportSkipped, "duplicate username in import file")
+			continue
+		}
+		if job.emails[email] {
+			job.result(row, constants.ImportSkipped, "duplicate email in import file")
+			continue
+		}
+		job.usernames[username], job.emails[email] = true, true
+
+		job.batch = append(job.batch, row)
+		if len(job.batch) >= batchSize {
+			if err = s.flush(ctx, job); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err = s.flush(ctx, job); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(job.report.Rows, func(i, j int) bool {
+		return job.report.Rows[i].Row < job.report.Rows[j].Row
+	})
+	if !job.dryRun {
+		err = s.repository.GetAudit().Record(ctx, constants.AuditUsersImported, constants.SubjectUser, nil, map[string]any{
+			"total":   job.report.Total,
+			"created": job.report.Created,
+			"skipped": job.report.Skipped,
+			"failed":  job.report.Failed,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return job.report, nil
+}
+
+// check memvalidasi satu baris dan mengembalikan alasan jika baris ditolak
+func (j *importJob) check(row *importRow) string {
+	if row.err != "" {
+		return row.err
+	}
+	request := &row.request
+	if request.ConfirmPassword == "" {
+		request.ConfirmPassword = request.Password
+	}
+	if err := j.validate.Struct(request); err != nil {
+		return joinFields(errWrap.ErrValidationResponse(err))
+	}
+	if request.Password != request.ConfirmPassword {
+		return errConstant.ErrPasswordDoesNotMatch.Error()
+	}
+
+	request.Attributes = attributeServices.MergeAttributes(nil, request.Attributes)
+	if err := attributeServices.ValidateAttributes(j.definitions, request.Attributes); err != nil {
+		var fieldErr *errWrap.FieldError
+		if errors.As(err, &fieldErr) {
+			return joinFields(fieldErr.Fields)
+		}
+		return err.Error()
+	}
+	return ""
+}
+
+func joinFields(fields []errWrap.ValidationResponse) string {
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, field.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// flush mencocokkan batch dengan user yang sudah ada lalu menyimpannya dalam satu transaksi
+func (s *ImportService) flush(ctx context.Context, job *importJob) error {
+	if len(job.batch) == 0 {
+		return nil
+	}
+	batch := job.batch
+	job.batch = nil
+
+	usernames := make([]string, 0, len(batch))
+	emails := make([]string, 0, len(batch))
+	for _, row := range batch {
+		usernames = append(usernames, strings.ToLower(row.request.Username))
+		emails = append(emails, strings.ToLower(row.request.Email))
+	}
+	existing, err := s.repository.GetUser().FindExisting(ctx, usernames, emails)
+	if err != nil {
+		return err
+	}
+	existingUsernames, existingEmails := map[string]bool{}, map[string]bool{}
+	for _, user := range existing {
+		existingUsernames[strings.ToLower(user.Username)] = true
+		existingEmails[strings.ToLower(user.Email)] = true
+	}
+
+	pending := make([]*importRow, 0, len(batch))
+	for _, row := range batch {
+		switch {
+		case existingUsernames[strings.ToLower(row.request.Username)]:
+			job.result(row, constants.ImportSkipped, errConstant.ErrUsernameExist.Error())
+		case existingEmails[strings.ToLower(row.request.Email)]:
+			job.result(row, constants.ImportSkipped, errConstant.ErrEmailExist.Error())
+		default:
+			pending = append(pending, row)
+		}
+	}
+	if job.dryRun {
+		for _, row := range pending {
+			job.result(row, constants.ImportCreated, "")
+		}
+		return nil
+	}
+
+	pending = hashPasswords(job, pending)
+	if len(pending) == 0 {
+		return nil
+	}
+	requests := make([]dto.RegisterRequest, 0, len(pending))
+	for _, row := range pending {
+		request := row.request
+		request.RoleIDs = []uint{constants.Customer}
+		requests = append(requests, request)
+	}
+	err = s.repository.Transaction(ctx, func(repository repositories.IRepositoryRegistry) error {
+		users, err := repository.GetUser().RegisterBatch(ctx, requests)
+		if err != nil {
+			return err
+		}
+		for i := range users {
+			changes := auditServices.Diff(nil, userServices.AuditSnapshot(&users[i]))
+			err = repository.GetAudit().RecordChanges(ctx, constants.AuditUserRegistered, constants.SubjectUser, &users[i].UUID, changes, map[string]any{
+				"source": "import",
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	for _, row := range pending {
+		if err != nil {
+			job.result(row, constants.ImportFailed, err.Error())
+			continue
+		}
+		job.result(row, constants.ImportCreated, "")
+	}
+	return nil
+}
+
+// hashPasswords meng-hash password secara paralel karena bcrypt mendominasi waktu import.
+// Baris yang gagal di-hash dicatat sebagai failed dan tidak ikut disimpan.
+func hashPasswords(job *importJob, rows []*importRow) []*importRow {
+	errs := make([]error, len(rows))
+	hashed := make([]string, len(rows))
+	limit := make(chan struct{}, runtime.NumCPU())
+
+	var wg sync.WaitGroup
+	for i, row := range rows {
+		wg.Go(func() {
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			password, err := bcrypt.GenerateFromPassword([]byte(row.request.Password), bcrypt.DefaultCost)
+			hashed[i], errs[i] = string(password), err
+		})
+	}
+	wg.Wait()
+
+	valid := rows[:0]
+	for i, row := range rows {
+		if errs[i] != nil {
+			job.result(row, constants.ImportFailed, errs[i].Error())
+			continue
+		}
+		row.request.Password = hashed[i]
+		valid = append(valid, row)
+	}
+	return valid
+}
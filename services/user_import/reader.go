@@ -0,0 +1,173 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"user-service/constants"
+	errConstant "user-service/constants/error"
+	"user-service/domain/dto"
+	"user-service/domain/models"
+)
+
+const attributeColumnPrefix = "attributes."
+
+// importRow adalah satu baris data import; err terisi jika baris tidak dapat dibaca
+type importRow struct {
+	number  int
+	request dto.RegisterRequest
+	err     string
+}
+
+// rowReader membaca baris import satu per satu tanpa memuat seluruh file ke memori.
+// io.EOF menandakan data habis; error lain menghentikan import.
+type rowReader interface {
+	Next() (*importRow, error)
+}
+
+func newRowReader(format string, reader io.Reader, definitions []models.AttributeDefinition) (rowReader, error) {
+	switch format {
+	case constants.ImportFormatCSV:
+		return newCSVReader(reader, definitions)
+	case constants.ImportFormatJSON:
+		return newJSONReader(reader)
+	default:
+		return nil, errConstant.ErrImportFormat
+	}
+}
+
+type csvReader struct {
+	reader      *csv.Reader
+	header      []string
+	definitions map[string]*models.AttributeDefinition
+	number      int
+}
+
+// newCSVReader membaca header berisi nama field JSON dari RegisterRequest
+// (name, username, email, phoneNumber, password, confirmPassword) dan kolom attributes.<key>
+func newCSVReader(reader io.Reader, definitions []models.AttributeDefinition) (rowReader, error) {
+	csvReader := &csvReader{reader: csv.NewReader(reader), definitions: map[string]*models.AttributeDefinition{}}
+	csvReader.reader.TrimLeadingSpace = true
+	for i := range definitions {
+		csvReader.definitions[definitions[i].Key] = &definitions[i]
+	}
+
+	header, err := csvReader.reader.Read()
+	if err != nil {
+		return nil, errConstant.ErrImportHeader
+	}
+	known := map[string]bool{"name": true, "username": true, "email": true, "phoneNumber": true, "password": true, "confirmPassword": true}
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if !known[column] && !strings.HasPrefix(column, attributeColumnPrefix) {
+			return nil, errConstant.ErrImportHeader
+		}
+		header[i] = column
+	}
+	csvReader.header = header
+	return csvReader, nil
+}
+
+func (r *csvReader) Next() (*importRow, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	r.number++
+	row := &importRow{number: r.number}
+	if err != nil {
+		var parseErr *csv.ParseError
+		if !errors.As(err, &parseErr) {
+			return nil, errConstant.ErrImportFile
+		}
+		row.err = parseErr.Err.Error()
+		return row, nil
+	}
+
+	request := &row.request
+	for i, column := range r.header {
+		value := strings.TrimSpace(record[i])
+		switch column {
+		case "name":
+			request.Name = value
+		case "username":
+			request.Username = value
+		case "email":
+			request.Email = value
+		case "phoneNumber":
+			request.PhoneNumber = value
+		case "password":
+			request.Password = value
+		case "confirmPassword":
+			request.ConfirmPassword = value
+		default:
+			if value == "" {
+				continue
+			}
+			key := strings.TrimPrefix(column, attributeColumnPrefix)
+			if request.Attributes == nil {
+				request.Attributes = map[string]any{}
+			}
+			request.Attributes[key] = r.coerce(key, value)
+		}
+	}
+	return row, nil
+}
+
+// coerce mengubah nilai teks CSV ke tipe atribut; nilai yang gagal diubah
+// dibiarkan sebagai teks agar ditolak oleh validasi atribut
+func (r *csvReader) coerce(key, value string) any {
+	definition, ok := r.definitions[key]
+	if !ok {
+		return value
+	}
+	switch definition.Type {
+	case constants.AttributeNumber:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case constants.AttributeBoolean:
+		if boolean, err := strconv.ParseBool(value); err == nil {
+			return boolean
+		}
+	}
+	return value
+}
+
+type jsonReader struct {
+	decoder *json.Decoder
+	number  int
+}
+
+// newJSONReader membaca array JSON berisi objek RegisterRequest
+func newJSONReader(reader io.Reader) (rowReader, error) {
+	decoder := json.NewDecoder(reader)
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, errConstant.ErrImportFile
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errConstant.ErrImportFile
+	}
+	return &jsonReader{decoder: decoder}, nil
+}
+
+func (r *jsonReader) Next() (*importRow, error) {
+	if !r.decoder.More() {
+		return nil, io.EOF
+	}
+	r.number++
+	row := &importRow{number: r.number}
+	err := r.decoder.Decode(&row.request)
+	if err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) {
+			return nil, errConstant.ErrImportFile
+		}
+		row.err = typeErr.Error()
+	}
+	return row, nil
+}